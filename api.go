@@ -1,42 +1,266 @@
 package curve4q
 
 import (
-	"encoding/binary"
+	"errors"
+	"sync/atomic"
+)
+
+// basePoint392 and the tables for it are in tables.go
+//go:generate go test -run=TestTables -update
+
+var (
+	basePoint = affine{Gx, Gy}
 )
 
 var (
-	basePoint          = affine{Gx, Gy}
-	basePoint392       = mulWindowed(scalar{392, 0, 0, 0}, _AffineToR1(basePoint), nil)
-	basePointTableWin  = tableWindowed(basePoint392)
-	basePointTableEndo = tableEndo(basePoint392)
+	ErrFault        = errors.New("curve4q: fault detected during scalar multiplication")
+	ErrInvalidPoint = errors.New("curve4q: invalid point encoding")
+	ErrNeutralPoint = errors.New("curve4q: result is the neutral point")
+	ErrKeyLength    = errors.New("curve4q: derived key length is negative or too large")
+	ErrScalarLength = errors.New("curve4q: scalar is longer than 64 bytes")
+	ErrWindowWidth  = errors.New("curve4q: unsupported window width")
+
+	ErrPointAtInfinity = errors.New("curve4q: point maps to the point at infinity")
 )
 
-func decodeScalar(in *[32]byte) (m scalar) {
-	m[0] = binary.LittleEndian.Uint64(in[0:8])
-	m[1] = binary.LittleEndian.Uint64(in[8:16])
-	m[2] = binary.LittleEndian.Uint64(in[16:24])
-	m[3] = binary.LittleEndian.Uint64(in[24:32]) & 0x000fffffffffffff
+// Scalars are little-endian integers, which are reduced modulo N, as in
+// FourQlib.  Every entry point decodes them this way, so that they all
+// compute the same function.
+func decodeScalar(in *[32]byte) scalar {
+	return sreduce(in[:])
+}
+
+// Writes the result of a DH computation to dst, unless it failed
+func encodeResult(dst *[32]byte, Q affine, err error) error {
+	if err != nil {
+		return err
+	}
+	encodeTo(dst[:], Q)
+	return nil
+}
+
+func ScalarBaseMultWin(dst, in *[32]byte) error {
+	m := decodeScalar(in)
+	Q, err := dhWindowed(m, basePoint, basePointTableWin)
+	return encodeResult(dst, Q, err)
+}
+
+func ScalarBaseMultEndo(dst, in *[32]byte) error {
+	m := decodeScalar(in)
+	Q, err := dhEndo(m, basePoint, basePointTableEndo)
+	return encodeResult(dst, Q, err)
+}
+
+// ScalarMultWin computes the same function as ScalarMult, but without
+// endomorphisms.  The scalar is a little-endian integer of up to 64 bytes,
+// which is reduced modulo N; ErrScalarLength is returned for a longer one.
+// The window width w must be between 2 and 7, or 0 to choose one
+// automatically; ErrWindowWidth is returned for any other width.
+func ScalarMultWin(dst *[32]byte, in []byte, base *[32]byte, w uint) error {
+	if len(in) > 64 {
+		return ErrScalarLength
+	}
+	if w == 0 {
+		w = windowWidth(false)
+	}
+	if w < windowMin || w > windowMax {
+		return ErrWindowWidth
+	}
+
+	mul := func(m scalar, P r1, table []r2) r1 {
+		return mulWindowed(m, P, tableWindowed(P, w))
+	}
+
+	m := sreduce(in)
+	P, err := decode(base[:])
+	if err != nil {
+		return err
+	}
+	Q, err := dhCore(m, P, mul, nil)
+	return encodeResult(dst, Q, err)
+}
+
+// ScalarBaseMultVarTime computes the same function as ScalarBaseMultEndo,
+// but in variable time.  It must only be used with public scalars, e.g.,
+// in signature verification.
+func ScalarBaseMultVarTime(dst, in *[32]byte) error {
+	m := decodeScalar(in)
+	Q, err := dhVarTime(m, basePoint, basePointTableVarTime)
+	return encodeResult(dst, Q, err)
+}
+
+// ScalarMultVarTime computes the same function as ScalarMult, but in
+// variable time.  It must only be used with public scalars.
+func ScalarMultVarTime(dst, in, base *[32]byte) error {
+	m := decodeScalar(in)
+	P, err := decode(base[:])
+	if err != nil {
+		return err
+	}
+	Q, err := dhVarTime(m, P, nil)
+	return encodeResult(dst, Q, err)
+}
+
+// ScalarMult computes [392*m]P for the scalar m in in and the point P
+// encoded in base.  It returns ErrInvalidPoint if base is not a valid
+// encoding, and ErrNeutralPoint if the result is the neutral point, as it
+// is when P has small order.  Nothing is written to dst on error.
+func ScalarMult(dst, in, base *[32]byte) error {
+	m := decodeScalar(in)
+	P, err := decode(base[:])
+	if err != nil {
+		return err
+	}
+	Q, err := dhEndo(m, P, nil)
+	return encodeResult(dst, Q, err)
+}
+
+// A Point is a decoded and validated point on the curve
+type Point struct {
+	p affine
+}
+
+// DecodePoint decodes and validates an encoded point.  See also
+// DecodeBatch.
+func DecodePoint(buf *[32]byte) (*Point, error) {
+	P, err := decode(buf[:])
+	if err != nil {
+		return nil, err
+	}
+	return &Point{p: P}, nil
+}
+
+// Bytes returns the encoding of P
+func (P *Point) Bytes() (out [32]byte) {
+	encodeTo(out[:], P.p)
 	return
 }
 
-func ScalarBaseMultWin(dst, in *[32]byte) {
+// ScalarMultPoint is the same as ScalarMult, but for a point that has
+// already been decoded
+func ScalarMultPoint(dst, in *[32]byte, base *Point) error {
 	m := decodeScalar(in)
-	Q := dhWindowed(m, basePoint, basePointTableWin)
-	buf := encode(Q)
-	copy(dst[:], buf)
+	Q, err := dhEndo(m, base.p, nil)
+	return encodeResult(dst, Q, err)
+}
+
+// A PrecomputedPoint holds a validated point together with the
+// multiplication table for it.  Use one in place of a raw point when
+// the same point (e.g., a static peer key) is multiplied many times,
+// so that the table is only computed once.
+type PrecomputedPoint struct {
+	point affine
+	table []r2
+}
+
+func NewPrecomputedPoint(base *[32]byte) (*PrecomputedPoint, error) {
+	P, err := decode(base[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// dhCore multiplies [392]P, so that is what the table is for
+	table := tableEndo(mulCofactor(_AffineToR1(P)))
+	return &PrecomputedPoint{point: P, table: table}, nil
+}
+
+func ScalarMultPrecomputed(dst, in *[32]byte, base *PrecomputedPoint) error {
+	m := decodeScalar(in)
+	Q, err := dhEndo(m, base.point, base.table)
+	return encodeResult(dst, Q, err)
+}
+
+// A PrivateKey is a secret scalar together with the countermeasures to
+// apply when multiplying by it.
+type PrivateKey struct {
+	m scalar
+
+	// Randomize enables randomized projective coordinates and scalar
+	// blinding, to protect against differential power or EM analysis.
+	// It costs a few extra field multiplications per operation.
+	Randomize bool
+
+	// DetectFaults enables validation of the intermediate and final
+	// values of each operation, which then fails with ErrFault instead
+	// of returning a result computed under a fault (e.g., a glitch).
+	DetectFaults bool
+
+	// The public key, computed on first use by publicKey
+	pub atomic.Pointer[[32]byte]
+}
+
+func NewPrivateKey(in *[32]byte) *PrivateKey {
+	return &PrivateKey{m: decodeScalar(in)}
+}
+
+func (k *PrivateKey) dh(P affine, table []r2) (affine, error) {
+	if k.DetectFaults {
+		return dhChecked(k.m, P, table, k.Randomize)
+	}
+
+	mul := mulEndo
+	if k.Randomize {
+		mul = mulEndoRandomized
+	}
+	return dhCore(k.m, P, mul, table)
+}
+
+func (k *PrivateKey) ScalarBaseMult(dst *[32]byte) error {
+	Q, err := k.dh(basePoint, basePointTableEndo)
+	return encodeResult(dst, Q, err)
+}
+
+// Returns ScalarBaseMult of the key, which is only computed once
+func (k *PrivateKey) publicKey() (*[32]byte, error) {
+	if pub := k.pub.Load(); pub != nil {
+		return pub, nil
+	}
+
+	pub := new([32]byte)
+	if err := k.ScalarBaseMult(pub); err != nil {
+		return nil, err
+	}
+	k.pub.Store(pub)
+	return pub, nil
+}
+
+func (k *PrivateKey) ScalarMult(dst, base *[32]byte) error {
+	P, err := decode(base[:])
+	if err != nil {
+		return err
+	}
+	Q, err := k.dh(P, nil)
+	return encodeResult(dst, Q, err)
+}
+
+func (k *PrivateKey) ScalarMultPrecomputed(dst *[32]byte, base *PrecomputedPoint) error {
+	Q, err := k.dh(base.point, base.table)
+	return encodeResult(dst, Q, err)
+}
+
+// A Multiplier holds the scratch space used by scalar multiplication, so
+// that multiplications with it do not allocate.  A Multiplier can be
+// reused, but not by more than one goroutine at a time.
+type Multiplier struct {
+	x endoScratch
+}
+
+func (mm *Multiplier) mul(m scalar, P r1, table []r2) r1 {
+	return mulEndoCore(m, P, table, &mm.x)
 }
 
-func ScalarBaseMultEndo(dst, in *[32]byte) {
+func (mm *Multiplier) ScalarBaseMult(dst, in *[32]byte) error {
 	m := decodeScalar(in)
-	Q := dhEndo(m, basePoint, basePointTableEndo)
-	buf := encode(Q)
-	copy(dst[:], buf)
+	Q, err := dhCore(m, basePoint, mm.mul, basePointTableEndo)
+	return encodeResult(dst, Q, err)
 }
 
-func ScalarMult(dst, in, base *[32]byte) {
+func (mm *Multiplier) ScalarMult(dst, in, base *[32]byte) error {
 	m := decodeScalar(in)
-	P := decode(base[:])
-	Q := dhEndo(m, P, nil)
-	buf := encode(Q)
-	copy(dst[:], buf)
+	P, err := decode(base[:])
+	if err != nil {
+		return err
+	}
+	Q, err := dhCore(m, P, mm.mul, nil)
+	return encodeResult(dst, Q, err)
 }
@@ -0,0 +1,377 @@
+package curve4q
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/bifurcation/curve4q/internal/ref"
+)
+
+func TestScalarMultPrecomputed(t *testing.T) {
+	TEST_LOOPS := 20
+
+	var base [32]byte
+	copy(base[:], encode(affine{Gx, Gy}))
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		baseCopy := base
+		pre, err := NewPrecomputedPoint(&base)
+		if err != nil || base != baseCopy {
+			t.Fatalf("failed NewPrecomputedPoint test: %v", err)
+		}
+
+		var m, Q1, Q2 [32]byte
+		rand.Read(m[:])
+		ScalarMult(&Q1, &m, &base)
+		if err := ScalarMultPrecomputed(&Q2, &m, pre); err != nil || Q1 != Q2 {
+			t.Fatalf("failed precomputed ScalarMult test")
+		}
+
+		base = Q1
+	}
+}
+
+func TestPrivateKey(t *testing.T) {
+	TEST_LOOPS := 10
+
+	var base [32]byte
+	copy(base[:], encode(affine{Gx, Gy}))
+	pre, _ := NewPrecomputedPoint(&base)
+
+	test := func(randomize, detectFaults bool) {
+		for i := 0; i < TEST_LOOPS; i += 1 {
+			var m, Q1, Q2 [32]byte
+			rand.Read(m[:])
+			k := NewPrivateKey(&m)
+			k.Randomize = randomize
+			k.DetectFaults = detectFaults
+
+			ScalarBaseMultEndo(&Q1, &m)
+			if err := k.ScalarBaseMult(&Q2); err != nil || Q1 != Q2 {
+				t.Fatalf("failed PrivateKey.ScalarBaseMult test (%v, %v): %v", randomize, detectFaults, err)
+			}
+
+			ScalarMult(&Q1, &m, &base)
+			if err := k.ScalarMult(&Q2, &base); err != nil || Q1 != Q2 {
+				t.Fatalf("failed PrivateKey.ScalarMult test (%v, %v): %v", randomize, detectFaults, err)
+			}
+
+			if err := k.ScalarMultPrecomputed(&Q2, pre); err != nil || Q1 != Q2 {
+				t.Fatalf("failed PrivateKey.ScalarMultPrecomputed test (%v, %v): %v", randomize, detectFaults, err)
+			}
+		}
+	}
+
+	test(false, false)
+	test(true, false)
+	test(false, true)
+	test(true, true)
+}
+
+func TestPrivateKeyNeutral(t *testing.T) {
+	var m, zero, Q [32]byte
+	rand.Read(m[:])
+
+	for _, randomize := range []bool{false, true} {
+		for _, detectFaults := range []bool{false, true} {
+			k := NewPrivateKey(&m)
+			k.Randomize, k.DetectFaults = randomize, detectFaults
+
+			// Every point of small order is sent to the neutral point
+			for _, S := range smallOrderPoints {
+				var base [32]byte
+				copy(base[:], encode(S))
+				var err error
+				if panics(func() { err = k.ScalarMult(&Q, &base) }) || err != ErrNeutralPoint {
+					t.Fatalf("failed PrivateKey small order test (%v, %v): %v", randomize, detectFaults, err)
+				}
+			}
+
+			z := NewPrivateKey(&zero)
+			z.Randomize, z.DetectFaults = randomize, detectFaults
+			var err error
+			if panics(func() { err = z.ScalarBaseMult(&Q) }) || err != ErrNeutralPoint {
+				t.Fatalf("failed PrivateKey zero scalar test (%v, %v): %v", randomize, detectFaults, err)
+			}
+		}
+	}
+
+	// Points off the curve cannot be decoded, so call dhChecked directly
+	P := affine{fp2One, fp2One}
+	for _, randomize := range []bool{false, true} {
+		if _, err := dhChecked(decodeScalar(&m), P, nil, randomize); err != ErrInvalidPoint {
+			t.Fatalf("failed dhChecked invalid point test: %v", err)
+		}
+	}
+}
+
+func TestAPIErrors(t *testing.T) {
+	var m, zero, Q [32]byte
+	rand.Read(m[:])
+	var mm Multiplier
+
+	var bad [32]byte
+	bad[15] = 0x80
+	if _, err := NewPrecomputedPoint(&bad); err != ErrInvalidPoint {
+		t.Fatalf("failed NewPrecomputedPoint invalid point test: %v", err)
+	}
+
+	// Each function is given a scalar and a point, and ignores whichever
+	// it does not use
+	fns := map[string]func(in, base *[32]byte) error{
+		"ScalarBaseMultWin":     func(in, base *[32]byte) error { return ScalarBaseMultWin(&Q, in) },
+		"ScalarBaseMultEndo":    func(in, base *[32]byte) error { return ScalarBaseMultEndo(&Q, in) },
+		"ScalarBaseMultVarTime": func(in, base *[32]byte) error { return ScalarBaseMultVarTime(&Q, in) },
+		"ScalarBaseMultU":       func(in, base *[32]byte) error { return ScalarBaseMultU(&Q, in) },
+		"ScalarMult":            func(in, base *[32]byte) error { return ScalarMult(&Q, in, base) },
+		"ScalarMultWin":         func(in, base *[32]byte) error { return ScalarMultWin(&Q, in[:], base, 0) },
+		"ScalarMultVarTime":     func(in, base *[32]byte) error { return ScalarMultVarTime(&Q, in, base) },
+		"ScalarMultPoint": func(in, base *[32]byte) error {
+			P, err := DecodePoint(base)
+			if err != nil {
+				return err
+			}
+			return ScalarMultPoint(&Q, in, P)
+		},
+		"ScalarMultPrecomputed": func(in, base *[32]byte) error {
+			pre, err := NewPrecomputedPoint(base)
+			if err != nil {
+				return err
+			}
+			return ScalarMultPrecomputed(&Q, in, pre)
+		},
+		"Multiplier.ScalarBaseMult": func(in, base *[32]byte) error { return mm.ScalarBaseMult(&Q, in) },
+		"Multiplier.ScalarMult":     func(in, base *[32]byte) error { return mm.ScalarMult(&Q, in, base) },
+	}
+
+	// Scalars are reduced modulo N, so N is the same as zero
+	Nbuf := encodeScalar(N)
+
+	for name, f := range fns {
+		var G [32]byte
+		copy(G[:], encode(affine{Gx, Gy}))
+		if err := f(&zero, &G); err != ErrNeutralPoint {
+			t.Fatalf("failed %s zero scalar test: %v", name, err)
+		}
+		if err := f(&Nbuf, &G); err != ErrNeutralPoint {
+			t.Fatalf("failed %s scalar N test: %v", name, err)
+		}
+
+		if strings.Contains(name, "Base") {
+			continue
+		}
+
+		for _, S := range smallOrderPoints {
+			var base [32]byte
+			copy(base[:], encode(S))
+			var err error
+			if panics(func() { err = f(&m, &base) }) || err != ErrNeutralPoint {
+				t.Fatalf("failed %s small order test: %v", name, err)
+			}
+		}
+
+		if err := f(&m, &bad); err != ErrInvalidPoint {
+			t.Fatalf("failed %s invalid point test: %v", name, err)
+		}
+	}
+}
+
+func TestScalarMultWin(t *testing.T) {
+	TEST_LOOPS := 5
+
+	var base [32]byte
+	copy(base[:], encode(affine{Gx, Gy}))
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var m, Q1, Q2 [32]byte
+		rand.Read(m[:])
+		ScalarMult(&Q1, &m, &base)
+
+		for w := uint(0); w <= windowMax; w += 1 {
+			if w == 1 {
+				continue
+			}
+
+			if err := ScalarMultWin(&Q2, m[:], &base, w); err != nil || Q1 != Q2 {
+				t.Fatalf("failed ScalarMultWin test (w=%d)", w)
+			}
+		}
+
+		// Add a random multiple of N, extending the scalar to 64 bytes
+		var r [32]byte
+		rand.Read(r[:])
+		lb := big.NewInt(0).Mul(ref.FromLE(r[:]), ref.N)
+		lb.Add(lb, ref.FromLE(m[:]))
+		long := ref.ToLE(lb, 64)
+
+		if err := ScalarMultWin(&Q2, long[:], &base, 0); err != nil || Q1 != Q2 {
+			t.Fatalf("failed ScalarMultWin test (64-byte scalar)")
+		}
+
+		if ScalarMultWin(&Q2, make([]byte, 65), &base, 0) != ErrScalarLength {
+			t.Fatalf("failed ScalarMultWin length test")
+		}
+		for _, w := range []uint{1, windowMax + 1, 64} {
+			if ScalarMultWin(&Q2, m[:], &base, w) != ErrWindowWidth {
+				t.Fatalf("failed ScalarMultWin window width test (w=%d)", w)
+			}
+		}
+
+		base = Q1
+	}
+}
+
+func TestVarTime(t *testing.T) {
+	TEST_LOOPS := 20
+
+	var base [32]byte
+	copy(base[:], encode(affine{Gx, Gy}))
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var m, Q1, Q2 [32]byte
+		rand.Read(m[:])
+
+		ScalarBaseMultEndo(&Q1, &m)
+		if err := ScalarBaseMultVarTime(&Q2, &m); err != nil || Q1 != Q2 {
+			t.Fatalf("failed ScalarBaseMultVarTime test")
+		}
+
+		ScalarMult(&Q1, &m, &base)
+		if err := ScalarMultVarTime(&Q2, &m, &base); err != nil || Q1 != Q2 {
+			t.Fatalf("failed ScalarMultVarTime test")
+		}
+
+		base = Q1
+	}
+}
+
+func TestMultiplier(t *testing.T) {
+	TEST_LOOPS := 10
+
+	var mm Multiplier
+	var base [32]byte
+	copy(base[:], encode(affine{Gx, Gy}))
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var m, Q1, Q2 [32]byte
+		rand.Read(m[:])
+
+		ScalarBaseMultEndo(&Q1, &m)
+		if err := mm.ScalarBaseMult(&Q2, &m); err != nil || Q1 != Q2 {
+			t.Fatalf("failed Multiplier.ScalarBaseMult test")
+		}
+
+		ScalarMult(&Q1, &m, &base)
+		if err := mm.ScalarMult(&Q2, &m, &base); err != nil || Q1 != Q2 {
+			t.Fatalf("failed Multiplier.ScalarMult test")
+		}
+
+		base = Q1
+	}
+
+	var m, Q [32]byte
+	rand.Read(m[:])
+	allocs := testing.AllocsPerRun(10, func() {
+		mm.ScalarBaseMult(&Q, &m)
+		mm.ScalarMult(&Q, &m, &base)
+	})
+	if allocs != 0 {
+		t.Fatalf("failed Multiplier allocation test: %v allocations", allocs)
+	}
+}
+
+/********** Benchmarks **********/
+
+// Random scalars and points of order N, as encodings
+func benchAPIInputs(n int) (scalars, points [][32]byte) {
+	scalars = make([][32]byte, n)
+	points = make([][32]byte, n)
+	for i := range scalars {
+		rand.Read(scalars[i][:])
+		ScalarBaseMultEndo(&points[i], &scalars[i])
+	}
+	return
+}
+
+var benchPoint [32]byte
+
+func BenchmarkScalarBaseMultWin(b *testing.B) {
+	m, _ := benchAPIInputs(16)
+	benchOps(b, func(i int) { ScalarBaseMultWin(&benchPoint, &m[i%16]) })
+}
+
+func BenchmarkScalarBaseMultEndo(b *testing.B) {
+	m, _ := benchAPIInputs(16)
+	benchOps(b, func(i int) { ScalarBaseMultEndo(&benchPoint, &m[i%16]) })
+}
+
+func BenchmarkScalarBaseMultVarTime(b *testing.B) {
+	m, _ := benchAPIInputs(16)
+	benchOps(b, func(i int) { ScalarBaseMultVarTime(&benchPoint, &m[i%16]) })
+}
+
+func BenchmarkScalarMult(b *testing.B) {
+	m, P := benchAPIInputs(16)
+	benchOps(b, func(i int) { ScalarMult(&benchPoint, &m[i%16], &P[(i+1)%16]) })
+}
+
+func BenchmarkScalarMultWin(b *testing.B) {
+	m, P := benchAPIInputs(16)
+	benchOps(b, func(i int) { ScalarMultWin(&benchPoint, m[i%16][:], &P[(i+1)%16], 0) })
+}
+
+func BenchmarkScalarMultVarTime(b *testing.B) {
+	m, P := benchAPIInputs(16)
+	benchOps(b, func(i int) { ScalarMultVarTime(&benchPoint, &m[i%16], &P[(i+1)%16]) })
+}
+
+func BenchmarkScalarMultPoint(b *testing.B) {
+	m, P := benchAPIInputs(16)
+	Q, _ := DecodePoint(&P[0])
+	benchOps(b, func(i int) { ScalarMultPoint(&benchPoint, &m[i%16], Q) })
+}
+
+func BenchmarkScalarMultPrecomputed(b *testing.B) {
+	m, P := benchAPIInputs(16)
+	pre, _ := NewPrecomputedPoint(&P[0])
+	benchOps(b, func(i int) { ScalarMultPrecomputed(&benchPoint, &m[i%16], pre) })
+}
+
+func BenchmarkNewPrecomputedPoint(b *testing.B) {
+	_, P := benchAPIInputs(16)
+	benchOps(b, func(i int) { NewPrecomputedPoint(&P[i%16]) })
+}
+
+func BenchmarkDecodePoint(b *testing.B) {
+	_, P := benchAPIInputs(16)
+	benchOps(b, func(i int) { DecodePoint(&P[i%16]) })
+}
+
+func BenchmarkPrivateKey(b *testing.B) {
+	m, P := benchAPIInputs(16)
+	for _, flags := range []struct{ randomize, detectFaults bool }{
+		{false, false}, {true, false}, {false, true}, {true, true},
+	} {
+		k := NewPrivateKey(&m[0])
+		k.Randomize = flags.randomize
+		k.DetectFaults = flags.detectFaults
+		name := fmt.Sprintf("Randomize=%v,DetectFaults=%v", flags.randomize, flags.detectFaults)
+
+		b.Run("ScalarBaseMult/"+name, func(b *testing.B) {
+			benchOps(b, func(i int) { k.ScalarBaseMult(&benchPoint) })
+		})
+		b.Run("ScalarMult/"+name, func(b *testing.B) {
+			benchOps(b, func(i int) { k.ScalarMult(&benchPoint, &P[i%16]) })
+		})
+	}
+}
+
+func BenchmarkMultiplier(b *testing.B) {
+	m, P := benchAPIInputs(16)
+	var mm Multiplier
+	b.Run("ScalarBaseMult", func(b *testing.B) {
+		benchOps(b, func(i int) { mm.ScalarBaseMult(&benchPoint, &m[i%16]) })
+	})
+	b.Run("ScalarMult", func(b *testing.B) {
+		benchOps(b, func(i int) { mm.ScalarMult(&benchPoint, &m[i%16], &P[(i+1)%16]) })
+	})
+}
@@ -12,58 +12,44 @@ var (
 	_M2 uint64 = 0x00000000ffffffff // Half word mask
 )
 
-var (
-	fp2M = 0 // Multiplies
-	fp2S = 0 // Squares
-	fp2A = 0 // Adds
-	fp2I = 0 // Inverses
-)
-
-func clearCounters() {
-	fp2M = 0
-	fp2S = 0
-	fp2A = 0
-	fp2I = 0
-}
+// Numbers of multiplies, squares, adds, and inverses in GF(p^2); see
+// opcount.go
+type opCounts struct{ M, S, A, I int64 }
 
 type fpelt [2]uint64
 type fp2elt [2]fpelt
 
 var (
-	p      = fpelt{p0, p1}
-	fpZero = fpelt{0, 0}
-	fpOne  = fpelt{1, 0}
-	fpTwo  = fpelt{2, 0}
-	fpHalf = fpelt{0x0000000000000000, 0x4000000000000000}
-	fp2One = fp2elt{fpOne, fpZero}
-	fp2Two = fp2elt{fpTwo, fpZero}
+	p       = fpelt{p0, p1}
+	fpZero  = fpelt{0, 0}
+	fpOne   = fpelt{1, 0}
+	fpTwo   = fpelt{2, 0}
+	fpHalf  = fpelt{0x0000000000000000, 0x4000000000000000}
+	fp2Zero = fp2elt{fpZero, fpZero}
+	fp2One  = fp2elt{fpOne, fpZero}
+	fp2Two  = fp2elt{fpTwo, fpZero}
 )
 
 func fpint(x uint64) fpelt {
 	return fpelt{x, 0}
 }
 
-// Adapted from "math/big" internals
-// https://golang.org/src/math/big/arith.go#L34
+// Adapted from "math/bits" Add64
 // z1<<_W + z0 = x+y+c, with c == 0 or 1
+//
+// The carry is computed from the top bits of x, y and z0, rather than by
+// comparing them, so that there is no branch on secret data.
 func wadd(x, y, c uint64) (z1, z0 uint64) {
-	yc := y + c
-	z0 = x + yc
-	if z0 < x || yc < y {
-		z1 = 1
-	}
+	z0 = x + y + c
+	z1 = ((x & y) | ((x | y) &^ z0)) >> 63
 	return
 }
 
-// Adapted from "math/big" internals
-// https://golang.org/src/math/big/arith.go#L44
-// z1<<_W + z0 = x-y-c, with c == 0 or 1
+// Adapted from "math/bits" Sub64
+// z1<<_W + z0 = x-y-c, with c == 0 or 1, and z1 the borrow
 func wsub(x, y, c uint64) (z1, z0 uint64) {
-	yc := y + c
-	z0 = x - yc
-	if z0 > x || yc < y {
-		z1 = 1
-	}
+	z0 = x - y - c
+	z1 = ((^x & y) | (^(x ^ y) & z0)) >> 63
 	return
 }
 
@@ -288,7 +274,7 @@ func fp2select(c uint64, x, y fp2elt) fp2elt {
 }
 
 func fp2add(x, y fp2elt) (z fp2elt) {
-	fp2A += 1
+	countA(1)
 	return fp2elt{fpadd(x[0], y[0]), fpadd(x[1], y[1])}
 }
 
@@ -305,7 +291,7 @@ func fp2conj(x fp2elt) (z fp2elt) {
 }
 
 func fp2mul(x, y fp2elt) (z fp2elt) {
-	fp2M += 1
+	countM(1)
 	t00 := fpmul(x[0], y[0])
 	t11 := fpmul(x[1], y[1])
 
@@ -320,7 +306,7 @@ func fp2mul(x, y fp2elt) (z fp2elt) {
 }
 
 func fp2sqr(x fp2elt) (z fp2elt) {
-	fp2S += 1
+	countS(1)
 	//
 	xmin := fpsub(x[0], x[1])
 	xsum := fpadd(x[0], x[1])
@@ -331,20 +317,20 @@ func fp2sqr(x fp2elt) (z fp2elt) {
 }
 
 func fp2inv(x fp2elt) (z fp2elt) {
-	fp2I += 1
+	countI(1)
 	invmag := fpinv(fpadd(fpsqr(x[0]), fpsqr(x[1])))
-	fp2M -= 2
 	return fp2elt{fpmul(invmag, x[0]), fpmul(invmag, fpneg(x[1]))}
 }
 
-func fp2invsqrt(x fp2elt) (z fp2elt) {
+// Computes 1/sqrt(x), or reports false if x is not a square
+func fp2invsqrt(x fp2elt) (z fp2elt, ok bool) {
 	if x[1] == fpZero {
 		t := fpinvsqrt(x[0])
 		c := fpmul(x[0], fpsqr(t))
 		if c == fpOne {
-			return fp2elt{t, fpZero}
+			return fp2elt{t, fpZero}, true
 		} else {
-			return fp2elt{fpZero, t}
+			return fp2elt{fpZero, t}, true
 		}
 	}
 
@@ -352,21 +338,47 @@ func fp2invsqrt(x fp2elt) (z fp2elt) {
 	s := fpinvsqrt(n)
 	c := fpmul(n, s)
 	if fpmul(c, s) != fpOne {
-		// XXX: Should handle this more gracefully
-		panic("Number is not square")
+		return fp2Zero, false
 	}
 
 	delta := fpmul(fpadd(x[0], c), fpHalf)
 	g := fpinvsqrt(delta)
 	h := fpmul(delta, g)
 	if fpmul(h, g) != fpOne {
-		delta = fpmul(fpsub(x[0], c), fpHalf)
-		g = fpinvsqrt(delta)
-		h = fpmul(delta, g)
+		// If delta is not a square, then g^2 * delta = -1, and the other
+		// choice delta' = (x0 - c) / 2 = -x1^2 / (4 * delta) has square
+		// root h' = x1 * g / 2, with x1 * g' / 2 = 1 / g = -delta * g.
+		// This avoids a third exponentiation.
+		return fp2elt{
+			fpmul(fpmul(fpmul(x[1], g), fpHalf), s),
+			fpmul(fpmul(s, delta), g),
+		}, true
 	}
 
 	return fp2elt{
 		fpmul(h, s),
 		fpneg(fpmul(fpmul(fpmul(x[1], s), g), fpHalf)),
+	}, true
+}
+
+// Inverts each element of x in place, sharing a single inversion among
+// them (Montgomery's trick).  The elements of x must be non-zero, and
+// scratch must be at least as long as x.
+func fp2invBatch(x, scratch []fp2elt) {
+	if len(x) == 0 {
+		return
+	}
+
+	acc := fp2One
+	for i := range x {
+		scratch[i] = acc
+		acc = fp2mul(acc, x[i])
+	}
+
+	acc = fp2inv(acc)
+	for i := len(x) - 1; i >= 0; i -= 1 {
+		inv := fp2mul(acc, scratch[i])
+		acc = fp2mul(acc, x[i])
+		x[i] = inv
 	}
 }
@@ -3,9 +3,9 @@ package curve4q
 import (
 	"fmt"
 	"math/big"
+	"math/bits"
 	"math/rand"
 	"testing"
-	"time"
 )
 
 func randfp() (x fpelt) {
@@ -56,53 +56,29 @@ func TestMain(m *testing.M) {
 	m.Run()
 }
 
-func TestPerf(t *testing.T) {
-	speedtest := func(f func(i int)) time.Duration {
-		tic := time.Now()
-		for i := 0; i < TEST_LOOPS; i += 1 {
-			f(i)
-		}
-		toc := time.Now()
-		return toc.Sub(tic)
-	}
-
-	tests := map[string]func(int){
-		"add": func(i int) { fp2add(corpus2[i], corpus2[(i+1)%TEST_LOOPS]) },
-		"mul": func(i int) { fp2mul(corpus2[i], corpus2[(i+1)%TEST_LOOPS]) },
-		"sqr": func(i int) { fp2sqr(corpus2[i]) },
-		"inv": func(i int) { fp2inv(corpus2[i]) },
+func TestWAddSub(t *testing.T) {
+	edge := []uint64{0, 1, _m - 1, _m, 1 << 63, 1<<63 - 1}
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		edge = append(edge, rand.Uint64())
 	}
 
-	fmt.Println()
-	fmt.Printf("===== Time for %d field operations =====\n", TEST_LOOPS)
-	for name := range tests {
-		t1271 := speedtest(tests[name])
-		fmt.Printf("%5s %v\n", name, t1271)
+	for _, x := range edge {
+		for _, y := range edge[:16] {
+			for c := uint64(0); c <= 1; c += 1 {
+				z1, z0 := wadd(x, y, c)
+				s, cs := bits.Add64(x, y, c)
+				if z0 != s || z1 != cs {
+					t.Fatalf("failed wadd test %x + %x + %d", x, y, c)
+				}
+
+				z1, z0 = wsub(x, y, c)
+				d, bd := bits.Sub64(x, y, c)
+				if z0 != d || z1 != bd {
+					t.Fatalf("failed wsub test %x - %x - %d", x, y, c)
+				}
+			}
+		}
 	}
-	fmt.Println()
-
-	P1 := _AffineToR1(affine{Gx, Gy})
-	clearCounters()
-	tic := time.Now()
-	dbl(P1)
-	toc := time.Now()
-	fmt.Printf("dbl: M=%d S=%d A=%d I=%d t=%v\n", fp2M, fp2S, fp2A, fp2I, toc.Sub(tic))
-
-	m := randScalar()
-	P2 := affine{Gx, Gy}
-	clearCounters()
-	tic = time.Now()
-	dhWindowed(m, P2, nil)
-	toc = time.Now()
-	fmt.Printf("dh-win: M=%d S=%d A=%d I=%d t=%v\n", fp2M, fp2S, fp2A, fp2I, toc.Sub(tic))
-
-	m = randScalar()
-	P2 = affine{Gx, Gy}
-	clearCounters()
-	tic = time.Now()
-	dhEndo(m, P2, nil)
-	toc = time.Now()
-	fmt.Printf("dh-endo: M=%d S=%d A=%d I=%d t=%v\n", fp2M, fp2S, fp2A, fp2I, toc.Sub(tic))
 }
 
 func TestFPSelect(t *testing.T) {
@@ -266,3 +242,111 @@ func TestFPInvSqrt(t *testing.T) {
 		}
 	}
 }
+
+func TestFP2InvBatch(t *testing.T) {
+	x := make([]fp2elt, len(corpus2))
+	copy(x, corpus2)
+	fp2invBatch(x, make([]fp2elt, len(x)))
+
+	for i := range corpus2 {
+		if x[i] != fp2inv(corpus2[i]) {
+			t.Fatalf("fp2invBatch did not produce inverse [%d]", i)
+		}
+	}
+}
+
+func TestFP2InvSqrt(t *testing.T) {
+	for i := range corpus2 {
+		x := fp2sqr(corpus2[i])
+		z, ok := fp2invsqrt(x)
+		if !ok || fp2mul(fp2sqr(z), x) != fp2One {
+			t.Fatalf("fp2invsqrt did not produce inverse square root [%d]", i)
+		}
+	}
+
+	// 1 + 2i has norm 5, which is not a square mod p
+	if _, ok := fp2invsqrt(fp2elt{fpOne, fpTwo}); ok {
+		t.Fatalf("fp2invsqrt non-square test failed")
+	}
+}
+
+// Runs f b.N times, reporting allocations and, when built with the
+// "opcount" tag, the average number of field operations
+func benchOps(b *testing.B, f func(i int)) {
+	b.ReportAllocs()
+	clearCounters()
+	b.ResetTimer()
+	for i := 0; i < b.N; i += 1 {
+		f(i)
+	}
+	b.StopTimer()
+
+	if opcountEnabled {
+		c := counters()
+		n := float64(b.N)
+		b.ReportMetric(float64(c.M)/n, "M/op")
+		b.ReportMetric(float64(c.S)/n, "S/op")
+		b.ReportMetric(float64(c.A)/n, "A/op")
+		b.ReportMetric(float64(c.I)/n, "I/op")
+	}
+}
+
+var (
+	benchFp   fpelt
+	benchFp2  fp2elt
+	benchBool bool
+)
+
+func BenchmarkFPAdd(b *testing.B) {
+	benchOps(b, func(i int) { benchFp = fpadd(corpus[i%TEST_LOOPS], corpus[(i+1)%TEST_LOOPS]) })
+}
+
+func BenchmarkFPMul(b *testing.B) {
+	benchOps(b, func(i int) { benchFp = fpmul(corpus[i%TEST_LOOPS], corpus[(i+1)%TEST_LOOPS]) })
+}
+
+func BenchmarkFPSqr(b *testing.B) {
+	benchOps(b, func(i int) { benchFp = fpsqr(corpus[i%TEST_LOOPS]) })
+}
+
+func BenchmarkFPInv(b *testing.B) {
+	benchOps(b, func(i int) { benchFp = fpinv(corpus[i%TEST_LOOPS]) })
+}
+
+func BenchmarkFPInvSqrt(b *testing.B) {
+	benchOps(b, func(i int) { benchFp = fpinvsqrt(corpus[i%TEST_LOOPS]) })
+}
+
+func BenchmarkFP2Add(b *testing.B) {
+	benchOps(b, func(i int) { benchFp2 = fp2add(corpus2[i%TEST_LOOPS], corpus2[(i+1)%TEST_LOOPS]) })
+}
+
+func BenchmarkFP2Sub(b *testing.B) {
+	benchOps(b, func(i int) { benchFp2 = fp2sub(corpus2[i%TEST_LOOPS], corpus2[(i+1)%TEST_LOOPS]) })
+}
+
+func BenchmarkFP2Mul(b *testing.B) {
+	benchOps(b, func(i int) { benchFp2 = fp2mul(corpus2[i%TEST_LOOPS], corpus2[(i+1)%TEST_LOOPS]) })
+}
+
+func BenchmarkFP2Sqr(b *testing.B) {
+	benchOps(b, func(i int) { benchFp2 = fp2sqr(corpus2[i%TEST_LOOPS]) })
+}
+
+func BenchmarkFP2Inv(b *testing.B) {
+	benchOps(b, func(i int) { benchFp2 = fp2inv(corpus2[i%TEST_LOOPS]) })
+}
+
+func BenchmarkFP2InvSqrt(b *testing.B) {
+	benchOps(b, func(i int) { benchFp2, benchBool = fp2invsqrt(corpus2[i%TEST_LOOPS]) })
+}
+
+// Inverts a batch of 64 elements per iteration
+func BenchmarkFP2InvBatch(b *testing.B) {
+	x := make([]fp2elt, 64)
+	scratch := make([]fp2elt, len(x))
+	benchOps(b, func(i int) {
+		copy(x, corpus2)
+		fp2invBatch(x, scratch)
+	})
+}
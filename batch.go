@@ -0,0 +1,120 @@
+package curve4q
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// A BatchJob is one multiplication for ScalarMultBatch, with the same
+// arguments as ScalarMult
+type BatchJob struct {
+	Scalar, Point [32]byte
+}
+
+// Number of jobs that a worker takes at a time.  The conversions of
+// their results to affine coordinates share a single inversion.
+const batchSize = 64
+
+// Splits [0, n) into chunks of batchSize, and processes them on up to
+// GOMAXPROCS goroutines.  Each goroutine calls newWorker once, and then
+// calls the function it returns on each of its chunks.
+func runBatch(n int, newWorker func() func(start, end int)) {
+	workers := min(runtime.GOMAXPROCS(0), (n+batchSize-1)/batchSize)
+
+	starts := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i += 1 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			work := newWorker()
+			for start := range starts {
+				work(start, min(start+batchSize, n))
+			}
+		}()
+	}
+
+	for start := 0; start < n; start += batchSize {
+		starts <- start
+	}
+	close(starts)
+	wg.Wait()
+}
+
+// ScalarMultBatch computes ScalarMult for each job, spreading the work
+// across GOMAXPROCS goroutines.  For each job, it returns either the
+// result or an error: ErrInvalidPoint, ErrNeutralPoint, or the error of
+// ctx if it is done before the job is started.
+func ScalarMultBatch(ctx context.Context, jobs []BatchJob) ([][32]byte, []error) {
+	out := make([][32]byte, len(jobs))
+	errs := make([]error, len(jobs))
+
+	runBatch(len(jobs), func() func(start, end int) {
+		w := new(batchWorker)
+		return func(start, end int) {
+			w.run(ctx, jobs[start:end], out[start:end], errs[start:end])
+		}
+	})
+
+	return out, errs
+}
+
+// DecodeBatch decodes and validates each of bufs, spreading the work
+// across GOMAXPROCS goroutines.  For each buffer, it returns either the
+// point or ErrInvalidPoint.
+func DecodeBatch(bufs [][32]byte) ([]Point, []error) {
+	points := make([]Point, len(bufs))
+	errs := make([]error, len(bufs))
+
+	runBatch(len(bufs), func() func(start, end int) {
+		return func(start, end int) {
+			for i := start; i < end; i += 1 {
+				points[i].p, errs[i] = decode(bufs[i][:])
+			}
+		}
+	})
+
+	return points, errs
+}
+
+type batchWorker struct {
+	mm      Multiplier
+	Q       [batchSize]r1
+	Z, tmp  [batchSize]fp2elt
+	indices [batchSize]int
+}
+
+func (w *batchWorker) run(ctx context.Context, jobs []BatchJob, out [][32]byte, errs []error) {
+	n := 0
+	for i := range jobs {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+
+		P, err := decode(jobs[i].Point[:])
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		m := decodeScalar(&jobs[i].Scalar)
+		w.Q[n] = dhProjective(m, P, w.mm.mul, nil)
+		w.Z[n] = w.Q[n].Z
+		w.indices[n] = i
+		n += 1
+	}
+
+	fp2invBatch(w.Z[:n], w.tmp[:n])
+
+	O := affine{Ox, Oy}
+	for k := 0; k < n; k += 1 {
+		Q := affine{fp2mul(w.Q[k].X, w.Z[k]), fp2mul(w.Q[k].Y, w.Z[k])}
+		if Q == O {
+			errs[w.indices[k]] = ErrNeutralPoint
+			continue
+		}
+		encodeTo(out[w.indices[k]][:], Q)
+	}
+}
@@ -0,0 +1,112 @@
+package curve4q
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+)
+
+func TestScalarMultBatch(t *testing.T) {
+	TEST_LOOPS := 3*batchSize + 5
+
+	var G, O, bad [32]byte
+	copy(G[:], encode(affine{Gx, Gy}))
+	copy(O[:], encode(affine{Ox, Oy}))
+	bad[15] = 0x80
+
+	jobs := make([]BatchJob, TEST_LOOPS)
+	for i := range jobs {
+		rand.Read(jobs[i].Scalar[:])
+		jobs[i].Point = G
+		if i > 0 {
+			ScalarMult(&jobs[i].Point, &jobs[i-1].Scalar, &jobs[i-1].Point)
+		}
+	}
+	jobs[7].Point = O
+	jobs[batchSize+1].Point = bad
+
+	out, errs := ScalarMultBatch(context.Background(), jobs)
+	for i := range jobs {
+		switch i {
+		case 7:
+			if errs[i] != ErrNeutralPoint {
+				t.Fatalf("failed ScalarMultBatch test (neutral point): %v", errs[i])
+			}
+		case batchSize + 1:
+			if errs[i] != ErrInvalidPoint {
+				t.Fatalf("failed ScalarMultBatch test (invalid point): %v", errs[i])
+			}
+		default:
+			var Q [32]byte
+			ScalarMult(&Q, &jobs[i].Scalar, &jobs[i].Point)
+			if errs[i] != nil || out[i] != Q {
+				t.Fatalf("failed ScalarMultBatch test [%d]: %v", i, errs[i])
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, errs = ScalarMultBatch(ctx, jobs)
+	for i := range jobs {
+		if errs[i] != context.Canceled {
+			t.Fatalf("failed ScalarMultBatch cancellation test [%d]: %v", i, errs[i])
+		}
+	}
+}
+
+func TestDecodeBatch(t *testing.T) {
+	TEST_LOOPS := 2*batchSize + 3
+
+	bufs := make([][32]byte, TEST_LOOPS)
+	copy(bufs[0][:], encode(affine{Gx, Gy}))
+	for i := 1; i < len(bufs); i += 1 {
+		var m [32]byte
+		rand.Read(m[:])
+		ScalarMult(&bufs[i], &m, &bufs[i-1])
+	}
+	bufs[5][15] |= 0x80
+	bufs[batchSize][0] ^= 1
+
+	points, errs := DecodeBatch(bufs)
+	for i := range bufs {
+		P, err := decode(bufs[i][:])
+		if errs[i] != err {
+			t.Fatalf("failed DecodeBatch error test [%d]: %v != %v", i, errs[i], err)
+		}
+		if err == nil && (points[i].p != P || points[i].Bytes() != bufs[i]) {
+			t.Fatalf("failed DecodeBatch test [%d]", i)
+		}
+	}
+	if errs[5] != ErrInvalidPoint {
+		t.Fatalf("failed DecodeBatch invalid point test")
+	}
+
+	var m, Q1, Q2 [32]byte
+	rand.Read(m[:])
+	ScalarMult(&Q1, &m, &bufs[1])
+	ScalarMultPoint(&Q2, &m, &points[1])
+	if Q1 != Q2 {
+		t.Fatalf("failed ScalarMultPoint test")
+	}
+}
+
+// Each iteration runs a full batch of batchSize jobs
+func BenchmarkScalarMultBatch(b *testing.B) {
+	m, P := benchAPIInputs(16)
+	jobs := make([]BatchJob, batchSize)
+	for i := range jobs {
+		jobs[i] = BatchJob{Scalar: m[i%16], Point: P[(i+1)%16]}
+	}
+	benchOps(b, func(i int) { ScalarMultBatch(context.Background(), jobs) })
+}
+
+// Each iteration decodes batchSize points
+func BenchmarkDecodeBatch(b *testing.B) {
+	_, P := benchAPIInputs(16)
+	bufs := make([][32]byte, batchSize)
+	for i := range bufs {
+		bufs[i] = P[i%16]
+	}
+	benchOps(b, func(i int) { DecodeBatch(bufs) })
+}
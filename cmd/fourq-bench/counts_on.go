@@ -0,0 +1,22 @@
+//go:build opcount
+
+package main
+
+import "github.com/bifurcation/curve4q"
+
+func resetOpCounts() {
+	curve4q.ResetOpCounts()
+}
+
+// Returns the average operation counts over n runs.  Counts are only
+// meaningful for curve4q operations, so an operation that performs no field
+// arithmetic reports none.
+func opCounts(n int) *counts {
+	M, S, A, I := curve4q.OpCounts()
+	if M+S+A+I == 0 {
+		return nil
+	}
+
+	N := float64(n)
+	return &counts{float64(M) / N, float64(S) / N, float64(A) / N, float64(I) / N}
+}
@@ -0,0 +1,194 @@
+// Command fourq-bench measures the latency and allocations of the curve4q
+// API, and compares them with X25519, P-256 and Ed25519 from the standard
+// library.  Built with the "opcount" tag, it also reports the number of
+// GF(p^2) multiplications, squarings, additions and inversions for each
+// curve4q operation.  Counting slows the field arithmetic, so latencies
+// should be taken from a build without the tag:
+//
+//	go run ./cmd/fourq-bench
+//	go run -tags opcount ./cmd/fourq-bench -run fourq
+//
+// The field and point operations are covered by the benchmarks in the
+// package itself (go test -bench .).
+package main
+
+import (
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/bifurcation/curve4q"
+)
+
+type benchmark struct {
+	name string
+	run  func(b *testing.B)
+}
+
+type result struct {
+	Name        string   `json:"name"`
+	NsPerOp     float64  `json:"ns_per_op"`
+	AllocsPerOp int64    `json:"allocs_per_op"`
+	BytesPerOp  int64    `json:"bytes_per_op"`
+	Counts      *counts  `json:"counts,omitempty"`
+	Relative    *float64 `json:"relative_to_x25519,omitempty"`
+}
+
+type counts struct {
+	M, S, A, I float64
+}
+
+func randBytes() (out [32]byte) {
+	rand.Read(out[:])
+	return
+}
+
+func benchmarks() []benchmark {
+	m := randBytes()
+	var P, Q [32]byte
+	curve4q.ScalarBaseMultEndo(&P, &m)
+	var u [32]byte
+	curve4q.ScalarBaseMultU(&u, &m)
+	pre, _ := curve4q.NewPrecomputedPoint(&P)
+
+	x25519Priv, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	x25519Peer, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	p256Priv, _ := ecdh.P256().GenerateKey(rand.Reader)
+	p256Peer, _ := ecdh.P256().GenerateKey(rand.Reader)
+	seed := randBytes()
+	edPriv := ed25519.NewKeyFromSeed(seed[:])
+	edPub := edPriv.Public().(ed25519.PublicKey)
+	msg := []byte("fourq-bench")
+	sig := ed25519.Sign(edPriv, msg)
+
+	loop := func(f func()) func(b *testing.B) {
+		return func(b *testing.B) {
+			for i := 0; i < b.N; i += 1 {
+				f()
+			}
+		}
+	}
+
+	return []benchmark{
+		{"fourq/ScalarBaseMultEndo", loop(func() { curve4q.ScalarBaseMultEndo(&Q, &m) })},
+		{"fourq/ScalarBaseMultWin", loop(func() { curve4q.ScalarBaseMultWin(&Q, &m) })},
+		{"fourq/ScalarBaseMultVarTime", loop(func() { curve4q.ScalarBaseMultVarTime(&Q, &m) })},
+		{"fourq/ScalarBaseMultU", loop(func() { curve4q.ScalarBaseMultU(&Q, &m) })},
+		{"fourq/ScalarMult", loop(func() { curve4q.ScalarMult(&Q, &m, &P) })},
+		{"fourq/ScalarMultWin", loop(func() { curve4q.ScalarMultWin(&Q, m[:], &P, 0) })},
+		{"fourq/ScalarMultVarTime", loop(func() { curve4q.ScalarMultVarTime(&Q, &m, &P) })},
+		{"fourq/ScalarMultPrecomputed", loop(func() { curve4q.ScalarMultPrecomputed(&Q, &m, pre) })},
+		{"fourq/ScalarMultU", loop(func() { curve4q.ScalarMultU(&Q, &m, &u) })},
+		{"fourq/DecodePoint", loop(func() { curve4q.DecodePoint(&P) })},
+
+		{"x25519/ScalarBaseMult", loop(func() {
+			k, _ := ecdh.X25519().NewPrivateKey(m[:])
+			k.PublicKey()
+		})},
+		{"x25519/ECDH", loop(func() { x25519Priv.ECDH(x25519Peer.PublicKey()) })},
+		{"p256/ScalarBaseMult", loop(func() { ecdh.P256().GenerateKey(rand.Reader) })},
+		{"p256/ECDH", loop(func() { p256Priv.ECDH(p256Peer.PublicKey()) })},
+		{"ed25519/NewKeyFromSeed", loop(func() { ed25519.NewKeyFromSeed(seed[:]) })},
+		{"ed25519/Sign", loop(func() { ed25519.Sign(edPriv, msg) })},
+		{"ed25519/Verify", loop(func() { ed25519.Verify(edPub, msg, sig) })},
+	}
+}
+
+// Runs a benchmark, recording the operation counts for the final round,
+// which is the one whose timing testing.Benchmark reports
+func measure(bm benchmark) result {
+	var c *counts
+	r := testing.Benchmark(func(b *testing.B) {
+		b.ReportAllocs()
+		resetOpCounts()
+		bm.run(b)
+		c = opCounts(b.N)
+	})
+
+	return result{
+		Name:        bm.name,
+		NsPerOp:     float64(r.T.Nanoseconds()) / float64(r.N),
+		AllocsPerOp: r.AllocsPerOp(),
+		BytesPerOp:  r.AllocedBytesPerOp(),
+		Counts:      c,
+	}
+}
+
+func main() {
+	// testing.Benchmark reads the -test.* flags, which testing.Init
+	// registers on flag.CommandLine.  The flags of the command are kept in
+	// their own set so that -h does not list them.
+	testing.Init()
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	run := fs.String("run", "", "only run benchmarks matching this regular expression")
+	benchtime := fs.String("benchtime", "1s", "time or number of iterations (e.g., 100x) for each benchmark")
+	asJSON := fs.Bool("json", false, "write the results as JSON")
+	fs.Parse(os.Args[1:])
+
+	re, err := regexp.Compile(*run)
+	if err == nil {
+		err = flag.Set("test.benchtime", *benchtime)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	var results []result
+	baseline := map[string]float64{}
+	for _, bm := range benchmarks() {
+		if !re.MatchString(bm.name) {
+			continue
+		}
+
+		r := measure(bm)
+		results = append(results, r)
+		if bm.name == "x25519/ScalarBaseMult" || bm.name == "x25519/ECDH" {
+			baseline[bm.name] = r.NsPerOp
+		}
+	}
+
+	// Compare base point and variable point multiplications with the
+	// corresponding X25519 operation
+	baseMult := regexp.MustCompile(`ScalarBaseMult|NewKeyFromSeed`)
+	for i := range results {
+		ref := baseline["x25519/ECDH"]
+		if baseMult.MatchString(results[i].Name) {
+			ref = baseline["x25519/ScalarBaseMult"]
+		}
+		if ref > 0 {
+			rel := results[i].NsPerOp / ref
+			results[i].Relative = &rel
+		}
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(results)
+		return
+	}
+
+	fmt.Printf("%-30s %12s %8s %8s %8s %8s %8s %8s %8s\n",
+		"operation", "ns/op", "x25519", "allocs", "B/op", "M", "S", "A", "I")
+	for _, r := range results {
+		rel := "-"
+		if r.Relative != nil {
+			rel = fmt.Sprintf("%.2fx", *r.Relative)
+		}
+		ops := [4]string{"-", "-", "-", "-"}
+		if r.Counts != nil {
+			for i, n := range []float64{r.Counts.M, r.Counts.S, r.Counts.A, r.Counts.I} {
+				ops[i] = fmt.Sprintf("%.0f", n)
+			}
+		}
+		fmt.Printf("%-30s %12.0f %8s %8d %8d %8s %8s %8s %8s\n",
+			r.Name, r.NsPerOp, rel, r.AllocsPerOp, r.BytesPerOp, ops[0], ops[1], ops[2], ops[3])
+	}
+}
@@ -0,0 +1,60 @@
+//go:build ctcheck
+
+// Command fourq-ctcheck tests curve4q for timing leaks, using the method of
+// dudect: each function is timed on a fixed input and on random inputs,
+// and the two distributions of timings are compared with Welch's t-test.
+// It exits with a non-zero status if a function that should be
+// constant-time appears to leak.  It needs access to internal functions,
+// so it must be built with the "ctcheck" tag:
+//
+//	go run -tags ctcheck ./cmd/fourq-ctcheck
+//
+// Timing measurements are noisy, so a result near the threshold should be
+// confirmed with more measurements on a quiet machine.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/bifurcation/curve4q"
+	"github.com/bifurcation/curve4q/internal/dudect"
+)
+
+func main() {
+	n := flag.Int("n", 100000, "number of measurements per function")
+	run := flag.String("run", "", "only test functions matching this regular expression")
+	flag.Parse()
+
+	re, err := regexp.Compile(*run)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	fmt.Printf("%-20s %12s %10s  %s\n", "function", "measurements", "max |t|", "result")
+	failed := 0
+	for _, target := range curve4q.CTTargets() {
+		if !re.MatchString(target.Name) {
+			continue
+		}
+
+		r := dudect.Measure(target, *n)
+		result := "ok"
+		switch {
+		case r.Leaks() && r.VarTime:
+			result = "leak (expected, variable-time)"
+		case r.Leaks():
+			result = "LEAK"
+			failed += 1
+		}
+		fmt.Printf("%-20s %12d %10.2f  %s\n", r.Name, r.Measurements, r.MaxT, result)
+	}
+
+	if failed > 0 {
+		fmt.Printf("%d functions may leak (threshold |t| > %.1f)\n", failed, dudect.Threshold)
+		os.Exit(1)
+	}
+}
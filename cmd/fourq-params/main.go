@@ -0,0 +1,36 @@
+//go:build params
+
+// Command fourq-params checks the curve parameters hard-coded in curve4q
+// against independent computations with math/big.  It exits with a
+// non-zero status if any check fails.  The parameters are not exported by
+// curve4q, so it must be built with the "params" tag:
+//
+//	go run -tags params ./cmd/fourq-params
+//
+// The same checks are run by the tests of curve4q.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bifurcation/curve4q"
+	"github.com/bifurcation/curve4q/internal/params"
+)
+
+func main() {
+	failed := 0
+	for _, check := range params.Audit(curve4q.ParamConstants()) {
+		if check.Err != nil {
+			fmt.Printf("FAIL  %s: %v\n", check.Name, check.Err)
+			failed += 1
+			continue
+		}
+		fmt.Printf("ok    %s\n", check.Name)
+	}
+
+	if failed > 0 {
+		fmt.Printf("%d checks failed\n", failed)
+		os.Exit(1)
+	}
+}
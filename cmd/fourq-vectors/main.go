@@ -0,0 +1,292 @@
+// Command fourq-vectors generates test vectors for other implementations
+// of FourQ, so that they can be checked layer by layer: arithmetic in
+// GF(p^2), point addition and doubling, point encoding, ScalarBaseMult,
+// ScalarMult, and the decomposition and recoding of scalars.  The vectors
+// are a deterministic function of the seed.
+//
+// Expected values are computed with the reference implementation in
+// internal/ref, except for the decomposition and recoding, which are
+// specific to the optimized implementation.  These are taken from
+// curve4q.Decompose and curve4q.Recode, and checked against the
+// eigenvalues of the endomorphisms.
+//
+// The vectors checked in to testdata/vectors were generated with
+//
+//	go run ./cmd/fourq-vectors > testdata/vectors/fourq.json
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+
+	"github.com/bifurcation/curve4q"
+	"github.com/bifurcation/curve4q/internal/ref"
+)
+
+type fieldVector struct {
+	A   string `json:"a"`
+	B   string `json:"b"`
+	Add string `json:"add"`
+	Sub string `json:"sub"`
+	Mul string `json:"mul"`
+	Sqr string `json:"sqr"`
+	Inv string `json:"inv"`
+}
+
+type pointVector struct {
+	Comment string `json:"comment"`
+	P       string `json:"p"`
+	Q       string `json:"q"`
+	Add     string `json:"add"`
+	Double  string `json:"double"`
+}
+
+type encodingVector struct {
+	Comment  string `json:"comment"`
+	Encoding string `json:"encoding"`
+	Valid    bool   `json:"valid"`
+	X        string `json:"x,omitempty"`
+	Y        string `json:"y,omitempty"`
+}
+
+type scalarMultVector struct {
+	Scalar string `json:"scalar"`
+	Point  string `json:"point,omitempty"`
+	Result string `json:"result"`
+}
+
+type decompositionVector struct {
+	Scalar string    `json:"scalar"`
+	A      [4]string `json:"a"`
+	Signs  []uint64  `json:"signs"`
+	Digits []uint64  `json:"digits"`
+}
+
+type vectorFile struct {
+	Seed           int64                 `json:"seed"`
+	Header         []string              `json:"header"`
+	Field          []fieldVector         `json:"field"`
+	Points         []pointVector         `json:"points"`
+	Encoding       []encodingVector      `json:"encoding"`
+	ScalarBaseMult []scalarMultVector    `json:"scalarBaseMult"`
+	ScalarMult     []scalarMultVector    `json:"scalarMult"`
+	Decomposition  []decompositionVector `json:"decomposition"`
+}
+
+var header = []string{
+	"Elements of GF(p^2), p = 2^127 - 1, are encoded as the 16-byte little-endian encodings of their real and imaginary parts.",
+	"Points are encoded as in curve4q: the encoding of y, with the top bit set to the sign of x.  The inverse of zero is zero.",
+	"Scalars are 32-byte little-endian integers.  ScalarBaseMult and ScalarMult reduce them modulo N, and compute [392 k]P.",
+	"Decomposition vectors give the coefficients a of k mod N as 64-bit hexadecimal integers, and the 65 recoded digits, least significant first.",
+	"Digit i is +/-(P + b0 phi(P) + b1 psi(P) + b2 psi(phi(P))), where b0, b1 and b2 are the bits of digits[i], negated if signs[i] is zero.",
+}
+
+var one = big.NewInt(1)
+
+func encodeFp2(x ref.Fp2) string {
+	return hex.EncodeToString(append(ref.ToLE(x.A, 16), ref.ToLE(x.B, 16)...))
+}
+
+func encodePoint(P ref.Point) string {
+	buf := ref.Encode(P)
+	return hex.EncodeToString(buf[:])
+}
+
+type generator struct {
+	rand *rand.Rand
+	n    int
+	out  vectorFile
+}
+
+func (g *generator) fp() *big.Int {
+	return new(big.Int).Rand(g.rand, ref.P)
+}
+
+func (g *generator) fp2() ref.Fp2 {
+	return ref.Fp2{A: g.fp(), B: g.fp()}
+}
+
+func (g *generator) scalar() [32]byte {
+	var m [32]byte
+	g.rand.Read(m[:])
+	return m
+}
+
+func (g *generator) point() ref.Point {
+	k := new(big.Int).Rand(g.rand, ref.N)
+	return ref.G.ScalarMult(k)
+}
+
+func (g *generator) field() {
+	pm1 := new(big.Int).Sub(ref.P, one)
+	values := []ref.Fp2{
+		ref.Int(0),
+		ref.Int(1),
+		ref.Int(-1),
+		{A: big.NewInt(0), B: big.NewInt(1)},
+		{A: pm1, B: pm1},
+	}
+	for i := 0; i < g.n; i += 1 {
+		values = append(values, g.fp2())
+	}
+
+	for i, a := range values {
+		b := values[(i+1)%len(values)]
+		g.out.Field = append(g.out.Field, fieldVector{
+			A:   encodeFp2(a),
+			B:   encodeFp2(b),
+			Add: encodeFp2(a.Add(b)),
+			Sub: encodeFp2(a.Sub(b)),
+			Mul: encodeFp2(a.Mul(b)),
+			Sqr: encodeFp2(a.Mul(a)),
+			Inv: encodeFp2(a.Inv()),
+		})
+	}
+}
+
+func (g *generator) addPoints(comment string, P, Q ref.Point) {
+	g.out.Points = append(g.out.Points, pointVector{
+		Comment: comment,
+		P:       encodePoint(P),
+		Q:       encodePoint(Q),
+		Add:     encodePoint(P.Add(Q)),
+		Double:  encodePoint(P.Double()),
+	})
+}
+
+func (g *generator) points() {
+	T2 := ref.Point{X: ref.Int(0), Y: ref.Int(-1)}
+	P := g.point()
+	g.addPoints("neutral point", ref.Neutral, P)
+	g.addPoints("point of order 2", T2, P)
+	g.addPoints("P + P", P, P)
+	g.addPoints("P + (-P)", P, P.Neg())
+	g.addPoints("point with a component of order 2", P.Add(T2), g.point())
+	for i := 0; i < g.n; i += 1 {
+		g.addPoints("random", g.point(), g.point())
+	}
+}
+
+func (g *generator) addEncoding(comment string, buf [32]byte) {
+	v := encodingVector{Comment: comment, Encoding: hex.EncodeToString(buf[:])}
+	if P, ok := ref.Decode(buf[:]); ok {
+		v.Valid, v.X, v.Y = true, encodeFp2(P.X), encodeFp2(P.Y)
+	}
+	g.out.Encoding = append(g.out.Encoding, v)
+}
+
+func (g *generator) encoding() {
+	g.addEncoding("neutral point", ref.Encode(ref.Neutral))
+	g.addEncoding("generator", ref.Encode(ref.G))
+	for i := 0; i < g.n; i += 1 {
+		g.addEncoding("random point", ref.Encode(g.point()))
+	}
+
+	// Random y-coordinates, about half of which are on the twist
+	for i := 0; i < g.n; i += 1 {
+		y := g.fp2()
+		var buf [32]byte
+		copy(buf[:16], ref.ToLE(y.A, 16))
+		copy(buf[16:], ref.ToLE(y.B, 16))
+		buf[31] |= byte(g.rand.Intn(2)) << 7
+		g.addEncoding("random y", buf)
+	}
+
+	buf := ref.Encode(ref.Neutral)
+	buf[31] |= 0x80
+	g.addEncoding("sign bit set for x = 0", buf)
+
+	buf = ref.Encode(g.point())
+	buf[15] |= 0x80
+	g.addEncoding("reserved bit set", buf)
+
+	buf = [32]byte(ref.ToLE(ref.P, 32))
+	g.addEncoding("real part of y equal to p", buf)
+}
+
+// Computes [392 k]P as ScalarMult does.  ScalarMult rejects a neutral
+// result, so none of the vectors should have one.
+func scalarMult(m [32]byte, P ref.Point) string {
+	k := new(big.Int).Mod(ref.FromLE(m[:]), ref.N)
+	Q := P.ScalarMult(new(big.Int).Mul(k, ref.Cofactor))
+	if Q.Equal(ref.Neutral) {
+		panic(fmt.Sprintf("neutral result for scalar %x", m))
+	}
+	return encodePoint(Q)
+}
+
+func (g *generator) scalarMults() {
+	scalars := [][32]byte{[32]byte(ref.ToLE(one, 32)), [32]byte(ref.ToLE(new(big.Int).Sub(ref.N, one), 32)), [32]byte(ref.ToLE(new(big.Int).Add(ref.N, one), 32))}
+	for len(scalars) < g.n+3 {
+		scalars = append(scalars, g.scalar())
+	}
+
+	for _, m := range scalars {
+		g.out.ScalarBaseMult = append(g.out.ScalarBaseMult, scalarMultVector{
+			Scalar: hex.EncodeToString(m[:]),
+			Result: scalarMult(m, ref.G),
+		})
+
+		P := g.point()
+		g.out.ScalarMult = append(g.out.ScalarMult, scalarMultVector{
+			Scalar: hex.EncodeToString(m[:]),
+			Point:  encodePoint(P),
+			Result: scalarMult(m, P),
+		})
+	}
+}
+
+func (g *generator) decomposition() {
+	lphiBuf, lpsiBuf := curve4q.Eigenvalues()
+	lphi, lpsi := ref.FromLE(lphiBuf[:]), ref.FromLE(lpsiBuf[:])
+	basis := []*big.Int{one, lphi, lpsi, new(big.Int).Mul(lphi, lpsi)}
+
+	scalars := [][32]byte{{}, [32]byte(ref.ToLE(one, 32)), [32]byte(ref.ToLE(new(big.Int).Sub(ref.N, one), 32))}
+	for len(scalars) < g.n+3 {
+		scalars = append(scalars, g.scalar())
+	}
+
+	for _, m := range scalars {
+		a := curve4q.Decompose(&m)
+		s, d := curve4q.Recode(a)
+
+		v := decompositionVector{Scalar: hex.EncodeToString(m[:]), Signs: s[:], Digits: d[:]}
+		sum := new(big.Int)
+		for i := range a {
+			v.A[i] = fmt.Sprintf("%016x", a[i])
+			sum.Add(sum, new(big.Int).Mul(new(big.Int).SetUint64(a[i]), basis[i]))
+		}
+		if sum.Sub(sum, ref.FromLE(m[:])).Mod(sum, ref.N).Sign() != 0 {
+			panic(fmt.Sprintf("decomposition of %x does not recombine", m))
+		}
+
+		g.out.Decomposition = append(g.out.Decomposition, v)
+	}
+}
+
+func main() {
+	seed := flag.Int64("seed", 1, "seed for the random choices")
+	n := flag.Int("n", 8, "number of random vectors of each kind")
+	flag.Parse()
+
+	g := &generator{rand: rand.New(rand.NewSource(*seed)), n: *n}
+	g.out.Seed = *seed
+	g.out.Header = header
+	g.field()
+	g.points()
+	g.encoding()
+	g.scalarMults()
+	g.decomposition()
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(g.out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,264 @@
+// Command fourq-wycheproof generates ECDH test vectors for curve4q in the
+// style of Project Wycheproof, concentrating on edge cases: invalid and
+// non-canonical encodings, twist points, low-order points and extreme
+// scalars.  Expected results are computed with the reference
+// implementation in internal/ref, so the vectors are independent of the
+// optimized code that they test.
+//
+// The vectors checked in to testdata/wycheproof were generated with
+//
+//	go run ./cmd/fourq-wycheproof > testdata/wycheproof/fourq_ecdh_test.json
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+
+	"github.com/bifurcation/curve4q/internal/ref"
+)
+
+type testVector struct {
+	TcID    int      `json:"tcId"`
+	Comment string   `json:"comment"`
+	Flags   []string `json:"flags"`
+	Private string   `json:"private"`
+	Public  string   `json:"public"`
+	Shared  string   `json:"shared"`
+	Result  string   `json:"result"`
+}
+
+type testGroup struct {
+	Curve    string       `json:"curve"`
+	Encoding string       `json:"encoding"`
+	Type     string       `json:"type"`
+	Tests    []testVector `json:"tests"`
+}
+
+type testFile struct {
+	Algorithm     string            `json:"algorithm"`
+	NumberOfTests int               `json:"numberOfTests"`
+	Header        []string          `json:"header"`
+	Notes         map[string]string `json:"notes"`
+	TestGroups    []testGroup       `json:"testGroups"`
+}
+
+var notes = map[string]string{
+	"EdgeCaseScalar":   "The private key is an extreme value that is still in range.",
+	"LargeScalar":      "The private key is not less than N.  ScalarMult reduces it modulo N, as FourQlib does, but an implementation that rejects the key instead is acceptable.",
+	"ZeroScalar":       "The private key is zero modulo N, so the shared secret is the neutral point.",
+	"TorsionComponent": "The public key is a point of order N plus a point of small order.  Multiplying by the cofactor removes the small-order component.",
+	"LowOrderPublic":   "The public key has order dividing the cofactor, so the shared secret is the neutral point.",
+	"TwistPoint":       "The public key encodes a y-coordinate for which x^2 is not a square, i.e., a point on the quadratic twist.",
+	"NonCanonicalY":    "A part of the y-coordinate is equal to p.",
+	"NonCanonicalSign": "The sign bit is set for a point with x = 0.",
+	"InvalidEncoding":  "The reserved bit 127 of the encoding is set.",
+}
+
+type generator struct {
+	rand  *rand.Rand
+	tests []testVector
+}
+
+var (
+	one    = big.NewInt(1)
+	mask   = new(big.Int).Sub(new(big.Int).Lsh(one, 244), one)
+	fifty6 = big.NewInt(56)
+)
+
+func (g *generator) scalar() [32]byte {
+	var m [32]byte
+	g.rand.Read(m[:])
+	m[30] &= 0x0f
+	m[31] = 0
+	return m
+}
+
+func (g *generator) point() ref.Point {
+	k := new(big.Int).Rand(g.rand, ref.N)
+	return ref.G.ScalarMult(k)
+}
+
+// A random encoding of a canonical y-coordinate, which may or may not
+// correspond to a point on the curve
+func (g *generator) encoding() (buf [32]byte) {
+	for {
+		g.rand.Read(buf[:])
+		buf[15] &= 0x7f
+		buf[31] &= 0x7f
+		y := ref.FromLE(buf[:])
+		y0 := new(big.Int).And(y, new(big.Int).Sub(new(big.Int).Lsh(one, 128), one))
+		y1 := new(big.Int).Rsh(y, 128)
+		if y0.Cmp(ref.P) < 0 && y1.Cmp(ref.P) < 0 {
+			return
+		}
+	}
+}
+
+// A point of order 56, the largest order among the points of small order
+func (g *generator) torsionPoint() ref.Point {
+	for {
+		buf := g.encoding()
+		Q, ok := ref.Decode(buf[:])
+		if !ok {
+			continue
+		}
+
+		T := Q.ScalarMult(ref.N)
+		if !T.ScalarMult(big.NewInt(28)).Equal(ref.Neutral) && !T.ScalarMult(big.NewInt(8)).Equal(ref.Neutral) {
+			return T
+		}
+	}
+}
+
+// Adds a test, computing the expected result as ScalarMult would: the
+// scalar is reduced modulo N and the point is multiplied by the
+// cofactor.  The shared secret is left empty if the result is the
+// neutral point, which ScalarMult rejects.
+func (g *generator) add(comment string, flags []string, m [32]byte, public [32]byte, result string) {
+	shared := ""
+	if P, ok := ref.Decode(public[:]); ok {
+		k := new(big.Int).Mod(ref.FromLE(m[:]), ref.N)
+		Q := P.ScalarMult(new(big.Int).Mul(k, ref.Cofactor))
+		if Q.Equal(ref.Neutral) && result == "valid" {
+			panic(fmt.Sprintf("neutral shared secret in %q", comment))
+		} else if !Q.Equal(ref.Neutral) {
+			enc := ref.Encode(Q)
+			shared = hex.EncodeToString(enc[:])
+		}
+	} else if result != "invalid" {
+		panic(fmt.Sprintf("invalid public key in %q", comment))
+	}
+
+	if flags == nil {
+		flags = []string{}
+	}
+	g.tests = append(g.tests, testVector{
+		TcID:    len(g.tests) + 1,
+		Comment: comment,
+		Flags:   flags,
+		Private: hex.EncodeToString(m[:]),
+		Public:  hex.EncodeToString(public[:]),
+		Shared:  shared,
+		Result:  result,
+	})
+}
+
+func (g *generator) generate() {
+	G := ref.Encode(ref.G)
+
+	// Normal cases
+	for i := 0; i < 8; i += 1 {
+		g.add("random", nil, g.scalar(), ref.Encode(g.point()), "valid")
+	}
+
+	// Scalars
+	g.add("scalar 1", []string{"EdgeCaseScalar"}, [32]byte(ref.ToLE(one, 32)), G, "valid")
+	g.add("scalar 2", []string{"EdgeCaseScalar"}, [32]byte(ref.ToLE(big.NewInt(2), 32)), G, "valid")
+	g.add("scalar 2^244 - 1", []string{"EdgeCaseScalar"}, [32]byte(ref.ToLE(mask, 32)), ref.Encode(g.point()), "valid")
+	g.add("scalar 2^243", []string{"EdgeCaseScalar"}, [32]byte(ref.ToLE(new(big.Int).Lsh(one, 243), 32)), ref.Encode(g.point()), "valid")
+	g.add("scalar 2^244", []string{"EdgeCaseScalar"}, [32]byte(ref.ToLE(new(big.Int).Lsh(one, 244), 32)), G, "valid")
+	g.add("scalar N - 1", []string{"EdgeCaseScalar"}, [32]byte(ref.ToLE(new(big.Int).Sub(ref.N, one), 32)), ref.Encode(g.point()), "valid")
+	g.add("scalar 0", []string{"ZeroScalar"}, [32]byte{}, G, "invalid")
+	g.add("scalar N", []string{"ZeroScalar", "LargeScalar"}, [32]byte(ref.ToLE(ref.N, 32)), G, "invalid")
+	g.add("scalar 2N", []string{"ZeroScalar", "LargeScalar"}, [32]byte(ref.ToLE(new(big.Int).Lsh(ref.N, 1), 32)), ref.Encode(g.point()), "invalid")
+	g.add("scalar N + 1", []string{"LargeScalar"}, [32]byte(ref.ToLE(new(big.Int).Add(ref.N, one), 32)), ref.Encode(g.point()), "acceptable")
+	g.add("scalar 2^256 - 1", []string{"LargeScalar"}, [32]byte(ref.ToLE(new(big.Int).Sub(new(big.Int).Lsh(one, 256), one), 32)), ref.Encode(g.point()), "acceptable")
+	for i := 0; i < 2; i += 1 {
+		m := g.scalar()
+		m[31] |= byte(g.rand.Intn(255) + 1)
+		g.add("random large scalar", []string{"LargeScalar"}, m, ref.Encode(g.point()), "acceptable")
+	}
+
+	// Points with a small-order component
+	T := g.torsionPoint()
+	for _, k := range []int64{1, 2, 4, 7, 8, 14, 28, 56} {
+		Tk := T.ScalarMult(new(big.Int).Div(fifty6, big.NewInt(k)))
+		comment := fmt.Sprintf("public key of order %d", k)
+		g.add(comment, []string{"LowOrderPublic"}, g.scalar(), ref.Encode(Tk), "invalid")
+		if !Tk.Neg().Equal(Tk) {
+			g.add(comment+", negated", []string{"LowOrderPublic"}, g.scalar(), ref.Encode(Tk.Neg()), "invalid")
+		}
+
+		comment = fmt.Sprintf("public key with a component of order %d", k)
+		g.add(comment, []string{"TorsionComponent"}, g.scalar(), ref.Encode(g.point().Add(Tk)), "valid")
+	}
+
+	// Invalid encodings
+	for i := 0; i < 4; i += 1 {
+		for {
+			buf := g.encoding()
+			if _, ok := ref.Decode(buf[:]); !ok {
+				g.add("point on the twist", []string{"TwistPoint"}, g.scalar(), buf, "invalid")
+				break
+			}
+		}
+	}
+
+	for _, P := range []ref.Point{ref.Neutral, {X: ref.Int(0), Y: ref.Int(1).Neg()}} {
+		buf := ref.Encode(P)
+		buf[31] |= 0x80
+		g.add("sign bit set for x = 0", []string{"NonCanonicalSign"}, g.scalar(), buf, "invalid")
+	}
+
+	for _, part := range []int{0, 1} {
+		// Find a point with a zero part in y, then replace that part by p
+		for {
+			buf := g.encoding()
+			for i := 16 * part; i < 16*part+16; i += 1 {
+				buf[i] = 0
+			}
+			if _, ok := ref.Decode(buf[:]); !ok {
+				continue
+			}
+
+			for i := 16 * part; i < 16*part+15; i += 1 {
+				buf[i] = 0xff
+			}
+			buf[16*part+15] |= 0x7f
+			g.add(fmt.Sprintf("part %d of y equal to p", part), []string{"NonCanonicalY"}, g.scalar(), buf, "invalid")
+			break
+		}
+	}
+
+	for i := 0; i < 2; i += 1 {
+		buf := ref.Encode(g.point())
+		buf[15] |= 0x80
+		g.add("reserved bit set", []string{"InvalidEncoding"}, g.scalar(), buf, "invalid")
+	}
+}
+
+func main() {
+	seed := flag.Int64("seed", 1, "seed for the random choices")
+	flag.Parse()
+
+	g := &generator{rand: rand.New(rand.NewSource(*seed))}
+	g.generate()
+
+	out := testFile{
+		Algorithm:     "FourQ-ECDH",
+		NumberOfTests: len(g.tests),
+		Header: []string{
+			"Test vectors of type EcdhTest check ScalarMult with the given private key and public key.",
+			"The shared secret is the encoding of [392 k]P, where k is the private key reduced modulo N.",
+		},
+		Notes: notes,
+		TestGroups: []testGroup{{
+			Curve:    "FourQ",
+			Encoding: "compressed",
+			Type:     "EcdhTest",
+			Tests:    g.tests,
+		}},
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/bifurcation/curve4q"
+	"github.com/bifurcation/curve4q/internal/ref"
+)
+
+// The divisors of the cofactor 392 = 2^3 * 7^2, in increasing order
+var cofactorDivisors = []int64{1, 2, 4, 7, 8, 14, 28, 49, 56, 98, 196, 392}
+
+// The "sign" of x, as in curve4q.sign
+func refSign(x ref.Fp2) uint {
+	if x.A.Sign() != 0 {
+		return x.A.Bit(126)
+	}
+	return x.B.Bit(126)
+}
+
+func formatFp2(x ref.Fp2) string {
+	return fmt.Sprintf("0x%032x + 0x%032x*i", x.A, x.B)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// Returns the order of a point on the curve
+func order(P ref.Point) string {
+	if P.Equal(ref.Neutral) {
+		return "1 (neutral point)"
+	}
+
+	// The order of [N]P is the order of the small-order component
+	Q := P.ScalarMult(ref.N)
+	var k int64
+	for _, k = range cofactorDivisors {
+		if Q.ScalarMult(big.NewInt(k)).Equal(ref.Neutral) {
+			break
+		}
+	}
+
+	switch {
+	case k == 1:
+		return "N (prime-order subgroup)"
+	case P.ScalarMult(ref.Cofactor).Equal(ref.Neutral):
+		return fmt.Sprintf("%d (small order)", k)
+	}
+	return fmt.Sprintf("%d*N (small-order component of order %d)", k, k)
+}
+
+// Walks through the steps of decoding, so that the reason for rejecting
+// an encoding is visible
+func inspectPoint(buf [32]byte) error {
+	reserved := buf[15] >> 7
+	sign := uint(buf[31] >> 7)
+	y := ref.Fp2{A: ref.FromLE(buf[0:16]), B: ref.FromLE(buf[16:32])}
+	y.A.SetBit(y.A, 127, 0)
+	y.B.SetBit(y.B, 127, 0)
+	yCanonical := y.A.Cmp(ref.P) < 0 && y.B.Cmp(ref.P) < 0
+
+	fmt.Printf("encoding:      %x\n", buf)
+	fmt.Printf("y:             %s\n", formatFp2(y))
+	fmt.Printf("reserved bit:  %d\n", reserved)
+	fmt.Printf("sign bit:      %d\n", sign)
+	fmt.Printf("y canonical:   %s\n", yesNo(yCanonical))
+	if !yCanonical {
+		fmt.Printf("               (a part of y is equal to p, and is reduced below)\n")
+	}
+
+	// x^2 = (y^2 - 1) / (d y^2 + 1), which is always defined since -1/d
+	// is not a square
+	y = ref.Fp2{A: new(big.Int).Mod(y.A, ref.P), B: new(big.Int).Mod(y.B, ref.P)}
+	y2 := y.Mul(y)
+	x2 := y2.Sub(ref.Int(1)).Mul(ref.D.Mul(y2).Add(ref.Int(1)).Inv())
+	x, onCurve := x2.Sqrt()
+	signCanonical := true
+	if onCurve {
+		if refSign(x) != sign {
+			x = x.Neg()
+		}
+		signCanonical = refSign(x) == sign
+		fmt.Printf("x:             %s\n", formatFp2(x))
+	} else {
+		fmt.Printf("x:             none (x^2 is not a square, so y is on the twist)\n")
+	}
+
+	fmt.Printf("on curve:      %s\n", yesNo(onCurve))
+	fmt.Printf("canonical:     %s\n", yesNo(reserved == 0 && yCanonical && signCanonical))
+	if reserved != 0 {
+		fmt.Printf("               (reserved bit is set)\n")
+	}
+	if !signCanonical {
+		fmt.Printf("               (sign bit is set, but x = 0)\n")
+	}
+	if onCurve {
+		fmt.Printf("order:         %s\n", order(ref.Point{X: x, Y: y}))
+	}
+
+	P, err := curve4q.DecodePoint(&buf)
+	if err != nil {
+		fmt.Printf("DecodePoint:   rejected (%v)\n", err)
+		return fmt.Errorf("invalid point")
+	}
+	fmt.Printf("DecodePoint:   accepted\n")
+	if P.IsSmallOrder() {
+		return fmt.Errorf("point of small order")
+	}
+	return nil
+}
+
+// Shows how ScalarMult processes a scalar: reduction modulo N,
+// decomposition, and recoding into signed digits
+func inspectScalar(in [32]byte) error {
+	k := ref.FromLE(in[:])
+	m := new(big.Int).Mod(k, ref.N)
+
+	fmt.Printf("scalar:        0x%064x\n", k)
+	fmt.Printf("reduced:       0x%064x\n", m)
+	if m.Cmp(k) != 0 {
+		fmt.Printf("               (the scalar is not less than N)\n")
+	}
+	if m.Sign() == 0 {
+		fmt.Printf("               (the scalar is zero modulo N, so the result is the neutral point)\n")
+	}
+
+	a := curve4q.Decompose(&in)
+	for i, ai := range a {
+		fmt.Printf("a[%d]:          0x%016x\n", i, ai)
+	}
+
+	// Digit i is +/-T[d], printed most significant first
+	s, d := curve4q.Recode(a)
+	var digits []string
+	for i := 64; i >= 0; i -= 1 {
+		sign := "-"
+		if s[i] == 1 {
+			sign = "+"
+		}
+		digits = append(digits, fmt.Sprintf("%s%d", sign, d[i]))
+	}
+	fmt.Printf("digits (64..0):\n")
+	for len(digits) > 0 {
+		n := min(len(digits), 13)
+		fmt.Printf("  %s\n", strings.Join(digits[:n], " "))
+		digits = digits[n:]
+	}
+	return nil
+}
+
+// Parses a scalar given on the command line.  Besides the key formats,
+// little-endian hex of fewer than 32 bytes is accepted, and zero-padded.
+func parseScalar(arg string) (in [32]byte, err error) {
+	if len(arg) < 64 {
+		if b, err := hex.DecodeString(arg); err == nil {
+			copy(in[:], b)
+			return in, nil
+		}
+	}
+	return parseKey([]byte(arg), pemPrivateKey)
+}
+
+func inspect(args []string) error {
+	fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+	isScalar := fs.Bool("scalar", false, "inspect a scalar rather than a point")
+	fs.Parse(args)
+
+	pemType := pemPublicKey
+	if *isScalar {
+		pemType = pemPrivateKey
+	}
+
+	var in [32]byte
+	var err error
+	switch {
+	case fs.NArg() == 0 || fs.Arg(0) == "-":
+		in, err = readKey("-", pemType)
+	case *isScalar:
+		in, err = parseScalar(fs.Arg(0))
+	default:
+		in, err = parseKey([]byte(fs.Arg(0)), pemType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *isScalar {
+		return inspectScalar(in)
+	}
+	return inspectPoint(in)
+}
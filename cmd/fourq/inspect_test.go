@@ -0,0 +1,37 @@
+package main
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestParseScalar(t *testing.T) {
+	var one, full, short [32]byte
+	one[0] = 1
+	for i := range full {
+		full[i] = byte(i + 1)
+	}
+	copy(short[:31], full[:])
+
+	for _, tc := range []struct {
+		arg  string
+		want [32]byte
+	}{
+		{"01", one},
+		{"", [32]byte{}},
+		{hex.EncodeToString(full[:]), full},
+		{hex.EncodeToString(full[:31]), short},
+	} {
+		got, err := parseScalar(tc.arg)
+		if err != nil || got != tc.want {
+			t.Fatalf("failed parseScalar test (%q): %v", tc.arg, err)
+		}
+	}
+
+	for _, arg := range []string{"1", "zz", strings.Repeat("00", 33)} {
+		if _, err := parseScalar(arg); err == nil {
+			t.Fatalf("failed parseScalar invalid input test (%q)", arg)
+		}
+	}
+}
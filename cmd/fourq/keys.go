@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	pemPrivateKey   = "FOURQ PRIVATE KEY"
+	pemPublicKey    = "FOURQ PUBLIC KEY"
+	pemSharedSecret = "FOURQ SHARED SECRET"
+)
+
+func readFile(name string) ([]byte, error) {
+	if name == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(name)
+}
+
+func isText(data []byte) bool {
+	for _, b := range data {
+		if (b < 0x20 || b > 0x7e) && b != '\n' && b != '\r' && b != '\t' {
+			return false
+		}
+	}
+	return true
+}
+
+// Parses a 32-byte value in PEM, hex, base64 or raw form.  A PEM block
+// must have the given type.
+func parseKey(data []byte, pemType string) (key [32]byte, err error) {
+	if len(data) == 32 && !isText(data) {
+		return [32]byte(data), nil
+	}
+
+	text := bytes.TrimSpace(data)
+	var raw []byte
+	switch {
+	case bytes.HasPrefix(text, []byte("-----BEGIN")):
+		block, _ := pem.Decode(text)
+		if block == nil {
+			return key, fmt.Errorf("malformed PEM")
+		}
+		if block.Type != pemType {
+			return key, fmt.Errorf("PEM type is %q, expected %q", block.Type, pemType)
+		}
+		raw = block.Bytes
+
+	case len(text) == 64:
+		raw, err = hex.DecodeString(string(text))
+
+	default:
+		raw, err = base64.StdEncoding.DecodeString(string(text))
+	}
+
+	if err != nil {
+		return key, fmt.Errorf("malformed key: expected hex, base64, PEM or 32 raw bytes")
+	}
+	if len(raw) != 32 {
+		return key, fmt.Errorf("key is %d bytes, expected 32", len(raw))
+	}
+	return [32]byte(raw), nil
+}
+
+func readKey(name, pemType string) (key [32]byte, err error) {
+	data, err := readFile(name)
+	if err != nil {
+		return key, err
+	}
+
+	key, err = parseKey(data, pemType)
+	if err != nil {
+		return key, fmt.Errorf("%s: %v", name, err)
+	}
+	return key, nil
+}
+
+func formatKey(key [32]byte, format, pemType string) ([]byte, error) {
+	switch format {
+	case "hex":
+		return []byte(hex.EncodeToString(key[:]) + "\n"), nil
+	case "base64":
+		return []byte(base64.StdEncoding.EncodeToString(key[:]) + "\n"), nil
+	case "pem":
+		return pem.EncodeToMemory(&pem.Block{Type: pemType, Bytes: key[:]}), nil
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// Writes a key to a file, or to standard output for "-".  Files are
+// created with permissions 0600, since they usually hold private keys.
+func writeKey(name string, key [32]byte, format, pemType string) error {
+	out, err := formatKey(key, format, pemType)
+	if err != nil {
+		return err
+	}
+
+	if name == "-" {
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+	return os.WriteFile(name, out, 0600)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+)
+
+func TestKeyFormats(t *testing.T) {
+	var key [32]byte
+	for i := range key {
+		key[i] = byte(i * 37)
+	}
+
+	for _, format := range []string{"hex", "base64", "pem"} {
+		out, err := formatKey(key, format, pemPublicKey)
+		if err != nil {
+			t.Fatalf("failed formatKey test (%s): %v", format, err)
+		}
+		parsed, err := parseKey(out, pemPublicKey)
+		if err != nil || parsed != key {
+			t.Fatalf("failed key round-trip test (%s): %v", format, err)
+		}
+	}
+
+	// Raw keys are not written by formatKey, but are accepted
+	if parsed, err := parseKey(key[:], pemPublicKey); err != nil || parsed != key {
+		t.Fatalf("failed key round-trip test (raw): %v", err)
+	}
+
+	if _, err := formatKey(key, "raw", pemPublicKey); err == nil {
+		t.Fatalf("failed formatKey unknown format test")
+	}
+
+	privKey, _ := formatKey(key, "pem", pemPrivateKey)
+	long := pem.EncodeToMemory(&pem.Block{Type: pemPublicKey, Bytes: append(key[:], 0)})
+	for _, tc := range []struct {
+		name string
+		data []byte
+		err  string
+	}{
+		{"PEM type", privKey, "PEM type"},
+		{"malformed PEM", []byte("-----BEGIN FOURQ PUBLIC KEY-----\n"), "malformed PEM"},
+		{"short base64", []byte(base64.StdEncoding.EncodeToString(key[:31])), "is 31 bytes"},
+		{"long PEM", long, "is 33 bytes"},
+		{"raw length", bytes.Repeat([]byte{0}, 33), "malformed key"},
+		{"bad hex", []byte(strings.Repeat("zz", 32)), "malformed key"},
+	} {
+		_, err := parseKey(tc.data, pemPublicKey)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Fatalf("failed parseKey %s test: %v", tc.name, err)
+		}
+	}
+}
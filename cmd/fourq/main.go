@@ -0,0 +1,162 @@
+// Command fourq performs key generation and Diffie-Hellman with curve4q.
+//
+//	fourq keygen [-format f] [-o file]   generate a private key
+//	fourq pub [-format f] [file]         compute the public key for a private key
+//	fourq dh -priv file -peer file [-format f]
+//	                                     compute a shared secret
+//	fourq inspect [-scalar] [value]      explain how a point or scalar is decoded
+//
+// Keys are read in hex, base64, PEM or raw binary form, which is detected
+// automatically, and written in the form given by -format: hex (the
+// default), base64 or pem.  A file name of "-" means standard input.
+//
+// The inspect command shows the steps in decoding a point: the parts of
+// y, the reserved and sign bits, the recovered x, and whether the point
+// is on the curve, canonically encoded and of small order.  With -scalar,
+// it shows how ScalarMult reduces, decomposes and recodes a scalar.
+// The value is given on the command line, or read from standard input.
+// A scalar on the command line may also be little-endian hex of fewer
+// than 32 bytes, e.g. "01", which is zero-padded.
+//
+// The shared secret is the encoding of a point, and should be passed
+// through a key derivation function before use.  Signatures are not yet
+// supported.
+package main
+
+import (
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/bifurcation/curve4q"
+)
+
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]command{
+	"keygen":  {"keygen [-format f] [-o file]", keygen},
+	"pub":     {"pub [-format f] [file]", pub},
+	"dh":      {"dh -priv file -peer file [-format f]", dh},
+	"inspect": {"inspect [-scalar] [value]", inspect},
+}
+
+func usage() {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "usage:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  fourq %s\n", commands[name].usage)
+	}
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+	}
+
+	if err := cmd.run(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "fourq %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func publicKey(priv *[32]byte) (pub [32]byte, err error) {
+	if err = curve4q.ScalarBaseMultEndo(&pub, priv); err != nil {
+		err = fmt.Errorf("invalid private key: %v", err)
+	}
+	return
+}
+
+func keygen(args []string) error {
+	fs := flag.NewFlagSet("keygen", flag.ExitOnError)
+	format := fs.String("format", "hex", "output format: hex, base64 or pem")
+	out := fs.String("o", "-", "output file")
+	fs.Parse(args)
+
+	// Only a scalar that is zero modulo N gives an unusable key,
+	// but check anyway rather than output one
+	var priv [32]byte
+	if _, err := rand.Read(priv[:]); err != nil {
+		return err
+	}
+	if _, err := publicKey(&priv); err != nil {
+		return err
+	}
+
+	return writeKey(*out, priv, *format, pemPrivateKey)
+}
+
+func pub(args []string) error {
+	fs := flag.NewFlagSet("pub", flag.ExitOnError)
+	format := fs.String("format", "hex", "output format: hex, base64 or pem")
+	fs.Parse(args)
+
+	in := "-"
+	if fs.NArg() > 0 {
+		in = fs.Arg(0)
+	}
+
+	priv, err := readKey(in, pemPrivateKey)
+	if err != nil {
+		return err
+	}
+
+	pub, err := publicKey(&priv)
+	if err != nil {
+		return err
+	}
+	return writeKey("-", pub, *format, pemPublicKey)
+}
+
+func dh(args []string) error {
+	fs := flag.NewFlagSet("dh", flag.ExitOnError)
+	format := fs.String("format", "hex", "output format: hex, base64 or pem")
+	privFile := fs.String("priv", "", "private key file")
+	peerFile := fs.String("peer", "", "peer public key file")
+	fs.Parse(args)
+
+	if *privFile == "" || *peerFile == "" {
+		return fmt.Errorf("both -priv and -peer are required")
+	}
+	if *privFile == "-" && *peerFile == "-" {
+		return fmt.Errorf("only one of -priv and -peer can be read from standard input")
+	}
+
+	priv, err := readKey(*privFile, pemPrivateKey)
+	if err != nil {
+		return err
+	}
+	peerKey, err := readKey(*peerFile, pemPublicKey)
+	if err != nil {
+		return err
+	}
+
+	peer, err := curve4q.DecodePoint(&peerKey)
+	if err != nil {
+		return fmt.Errorf("invalid peer public key: %v", err)
+	}
+	if peer.IsSmallOrder() {
+		return fmt.Errorf("invalid peer public key: point of small order")
+	}
+
+	var shared [32]byte
+	if err := curve4q.ScalarMultPoint(&shared, &priv, peer); err != nil {
+		return err
+	}
+	return writeKey("-", shared, *format, pemSharedSecret)
+}
@@ -0,0 +1,93 @@
+//go:build ctcheck
+
+package curve4q
+
+import (
+	"encoding/binary"
+
+	"github.com/bifurcation/curve4q/internal/dudect"
+)
+
+// Timing targets for cmd/fourq-ctcheck.  These expose internal functions,
+// so they are only built with the "ctcheck" tag.
+
+// Results are stored here so that the calls are not optimized away.  They
+// are not stored in an interface, since converting a zero value to an
+// interface does not allocate, and that is itself a timing difference.
+var (
+	ctSinkPoint  [32]byte
+	ctSinkFp     fpelt
+	ctSinkFp2    fp2elt
+	ctSinkScalar scalar
+	ctSinkOK     bool
+)
+
+func ctScalar(in []byte) (m scalar) {
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(in[8*i:])
+	}
+	return
+}
+
+func ctFp(in []byte) (x fpelt) {
+	x = fpelt{binary.LittleEndian.Uint64(in[0:]), binary.LittleEndian.Uint64(in[8:])}
+	fpreduce(&x)
+	return
+}
+
+func CTTargets() []dudect.Target {
+	base := [32]byte(encode(affine{Gx, Gy}))
+	return []dudect.Target{
+		{
+			Name:  "ScalarBaseMultEndo",
+			Size:  32,
+			Fixed: []byte{1},
+			Run: func(in []byte) {
+				var dst [32]byte
+				ScalarBaseMultEndo(&dst, (*[32]byte)(in))
+				ctSinkPoint = dst
+			},
+		},
+		{
+			Name:  "ScalarMult",
+			Size:  32,
+			Fixed: []byte{1},
+			Run: func(in []byte) {
+				var dst [32]byte
+				ScalarMult(&dst, (*[32]byte)(in), &base)
+				ctSinkPoint = dst
+			},
+		},
+		{
+			Name: "fpinv",
+			Size: 16,
+			Run: func(in []byte) {
+				ctSinkFp = fpinv(ctFp(in))
+			},
+		},
+		{
+			Name: "decompose",
+			Size: 32,
+			Run: func(in []byte) {
+				ctSinkScalar = decompose(ctScalar(in))
+			},
+		},
+		{
+			Name: "smodN",
+			Size: 32,
+			Run: func(in []byte) {
+				ctSinkScalar = smodN(ctScalar(in))
+			},
+		},
+		{
+			// Only used on public values, in decode and uOnCurve
+			Name:    "fp2invsqrt",
+			Size:    32,
+			VarTime: true,
+			Run: func(in []byte) {
+				x := fp2elt{ctFp(in[0:16]), ctFp(in[16:32])}
+				ctSinkFp2, ctSinkOK = fp2invsqrt(x)
+			},
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package curve4q
 
 import (
+	"crypto/rand"
 	"encoding/binary"
 )
 
@@ -43,58 +44,69 @@ func pointOnCurve(X, Y fp2elt) bool {
 /********** Point encoding / decoding **********/
 
 // "Sign" bit used in compression / decompression
-// s = (x0 != 0)? (x0 >> 127) : (x1 >> 127)
+// s = (x0 != 0)? (x0 >> 126) : (x1 >> 126)
 func sign(x fp2elt) byte {
 	x0z := wzero(x[0][0] | x[0][1])
-	s0 := x[0][1] >> 63
-	s1 := x[1][1] >> 63
-	return byte((s1 ^ (x0z & (s0 ^ s1))) & 0xFF)
+	s0 := (x[0][1] >> 62) & 1
+	s1 := (x[1][1] >> 62) & 1
+	return byte((s0 ^ (x0z & (s0 ^ s1))) & 0xFF)
 }
 
 func encode(P affine) []byte {
 	buf := make([]byte, 32)
+	encodeTo(buf, P)
+	return buf
+}
+
+// Same as encode, but writes the encoding into buf, which must have
+// length at least 32
+func encodeTo(buf []byte, P affine) {
 	binary.LittleEndian.PutUint64(buf[0:8], P.Y[0][0])
 	binary.LittleEndian.PutUint64(buf[8:16], P.Y[0][1])
 	binary.LittleEndian.PutUint64(buf[16:24], P.Y[1][0])
 	binary.LittleEndian.PutUint64(buf[24:32], P.Y[1][1])
-	buf[31] |= sign(P.X)
-	return buf
+	buf[31] |= sign(P.X) << 7
 }
 
-func decode(buf []byte) (P affine) {
-	// XXX: Should handle these more gracefully
-	if len(buf) != 32 {
-		panic("Malformed point: length is not 32")
-	}
-	if buf[15]&0x80 != 0x00 {
-		panic("Malformed point: reserved bit is not zero")
+// Decodes a point, returning ErrInvalidPoint if buf is not the canonical
+// encoding of a point on the curve
+func decode(buf []byte) (P affine, err error) {
+	if len(buf) != 32 || buf[15]&0x80 != 0x00 {
+		return affine{}, ErrInvalidPoint
 	}
 
 	s := buf[31] >> 7
-	buf[31] &= 0x7f
 
 	y00 := binary.LittleEndian.Uint64(buf[0:8])
 	y01 := binary.LittleEndian.Uint64(buf[8:16])
 	y10 := binary.LittleEndian.Uint64(buf[16:24])
-	y11 := binary.LittleEndian.Uint64(buf[24:32])
+	y11 := binary.LittleEndian.Uint64(buf[24:32]) & 0x7fffffffffffffff
 	P.Y = fp2elt{fpelt{y00, y01}, fpelt{y10, y11}}
 
+	// Reject non-canonical encodings, so that each point has only one
+	if P.Y[0] == p || P.Y[1] == p {
+		return affine{}, ErrInvalidPoint
+	}
+
 	y2 := fp2sqr(P.Y)
 	y21 := fp2sub(y2, fp2One)
 	dy21 := fp2add(fp2mul(d, y2), fp2One)
-	sqrt := fp2invsqrt(fp2mul(y21, dy21))
+	sqrt, ok := fp2invsqrt(fp2mul(y21, dy21))
+	if !ok {
+		return affine{}, ErrInvalidPoint
+	}
 	P.X = fp2mul(y21, sqrt)
 
 	if s != sign(P.X) {
 		P.X = fp2neg(P.X)
 	}
 
-	// XXX: Handle more gracefully
-	if !pointOnCurve(P.X, P.Y) {
-		panic("Malformed point: not on curve")
+	// If x = 0, then the sign bit must be zero
+	if s != sign(P.X) || !pointOnCurve(P.X, P.Y) {
+		return affine{}, ErrInvalidPoint
 	}
 
-	return
+	return P, nil
 }
 
 /********** Alternative Point Representations and Addition Laws **********/
@@ -212,38 +224,96 @@ func add(P1 r1, P2 r2) (P3 r1) {
 
 /********** Multiplication without Endomorphisms **********/
 
-func tableWindowed(P r1) (T []r2) {
+const (
+	// Range of supported window widths
+	windowMin = 2
+	windowMax = 7
+
+	// Upper bound on the bit length of the scalars passed to
+	// mulWindowedCore.  These are reduced modulo N, and may have N added
+	// to make them odd.
+	windowBits = 250
+)
+
+// Number of signed digits of width w needed for scalars of windowBits
+// bits, not counting the top digit.  Each digit consumes w-1 bits, and
+// this leaves at most w-2 bits for the top digit, so that it is odd
+// and less than 2^(w-1).
+func windowDigits(w uint) int {
+	return windowBits / int(w-1)
+}
+
+// Returns the window width that minimizes the number of point additions,
+// including the ones to compute the table if it is not precomputed
+func windowWidth(precomputed bool) (best uint) {
+	bestCost := -1
+	for w := uint(windowMin); w <= windowMax; w += 1 {
+		cost := windowDigits(w)
+		if !precomputed {
+			cost += 1 << (w - 2)
+		}
+		if bestCost < 0 || cost < bestCost {
+			best, bestCost = w, cost
+		}
+	}
+	return
+}
+
+// compute [1]P, [3]P, ..., [2n - 1]P
+func tableOdd(P r1, n int) (T []r2) {
 	Q := dbl(P)
-	T = make([]r2, 8)
+	T = make([]r2, n)
 	T[0] = _R1toR2(P)
-	for i := 1; i < 8; i += 1 {
+	for i := 1; i < len(T); i += 1 {
 		T[i] = _R1toR2(add(Q, T[i-1]))
 	}
 	return
 }
 
+// compute [1]P, [3]P, ..., [2^(w-1) - 1]P
+func tableWindowed(P r1, w uint) []r2 {
+	if w < windowMin || w > windowMax {
+		panic("Unsupported window width")
+	}
+	return tableOdd(P, 1<<(w-2))
+}
+
+// The window width is determined by the size of the table.  If no table
+// is provided, then one is computed with the width from windowWidth.
 func mulWindowed(m scalar, P r1, table []r2) (Q r1) {
-	// compute [1]P, [3]P, ..., [15]P and negatives
-	// XXX: Should check whether this table is for this point
+	// Note: The caller must ensure that the table is for P.  Tables
+	// that outlive a single call should be bound to their point with
+	// a PrecomputedPoint.
 	T := table
 	if T == nil {
-		T = tableWindowed(P)
+		T = tableWindowed(P, windowWidth(false))
+	}
+	return mulWindowedCore(smodN(m), T)
+}
+
+// Note: Requires m < 2^windowBits - N
+func mulWindowedCore(m scalar, T []r2) (Q r1) {
+	w := uint(2)
+	for (1 << (w - 2)) < len(T) {
+		w += 1
 	}
+
 	nT := make([]r2, len(T))
 	for i, P := range T {
 		nT[i] = _R2neg(P)
 	}
 
 	// Pre-compute scalars
-	d := make([]int, 63)
-	reduced := smodN(m)
+	n := windowDigits(w)
+	d := make([]int, n+1)
+	reduced := m
 	odd := reduced[0] & 1
 	reduced = sselect(odd, reduced, sadd(reduced, N))
-	for i := 0; i < 63; i += 1 {
-		d[i] = int(reduced[0]%32) - 16
-		reduced = srsh4(ssubi(reduced, d[i]))
+	for i := 0; i < n; i += 1 {
+		d[i] = int(reduced[0]%(1<<w)) - (1 << (w - 1))
+		reduced = srsh(ssubi(reduced, d[i]), w-1)
 	}
-	d[62] = int(reduced[0])
+	d[n] = int(reduced[0])
 
 	ind := make([]int, len(d))
 	sgn := make([]uint64, len(d))
@@ -255,9 +325,11 @@ func mulWindowed(m scalar, P r1, table []r2) (Q r1) {
 	}
 
 	// Compute the product
-	Q = _R2toR1(_R2select(sgn[62], T[ind[62]], nT[ind[62]]))
-	for i := 61; i >= 0; i -= 1 {
-		Q = dbl(dbl(dbl(dbl(Q))))
+	Q = _R2toR1(_R2select(sgn[n], T[ind[n]], nT[ind[n]]))
+	for i := n - 1; i >= 0; i -= 1 {
+		for j := uint(1); j < w; j += 1 {
+			Q = dbl(Q)
+		}
 		Q = add(Q, _R2select(sgn[i], T[ind[i]], nT[ind[i]]))
 	}
 	return
@@ -282,6 +354,11 @@ var (
 	cpsi2    = fp2elt{fpelt{0x0000000000000143, 0x00000000000000e4}, fpelt{0x4c7deb770e03f372, 0x21b8d07b99a81f03}}
 	cpsi3    = fp2elt{fpelt{0x0000000000000009, 0x0000000000000006}, fpelt{0x3a6e6abe75e73a61, 0x4cb26f161d7d6906}}
 	cpsi4    = fp2elt{fpelt{0xfffffffffffffff6, 0x7ffffffffffffff9}, fpelt{0xc59195418a18c59e, 0x334d90e9e28296f9}}
+
+	// Eigenvalues of phi, psi, and psi * phi on the subgroup of order N
+	lambdaPhi    = scalar{0xcdf0e63ce8997864, 0x24874f0b5e4daa9b, 0x83c18f03c13d7376, 0x0006d9069dc876c8}
+	lambdaPsi    = scalar{0x5dc7f2b7cdfd2a88, 0xcb3688ca09ef0e88, 0x8c4c2510ab53b51e, 0x0018c47535351926}
+	lambdaPhiPsi = scalar{0xe27d32386d92daba, 0xeaaaebb7756d5f80, 0x01cc07f2197ccf13, 0x0017f1d82d2b6177}
 )
 
 func tau(P r4) (Q r4) {
@@ -383,13 +460,29 @@ func decompose(m scalar) (a scalar) {
 	return
 }
 
+// Inverse of decompose, modulo N
+func recombine(a scalar) (m scalar) {
+	m = scalar{a[0], 0, 0, 0}
+	m = saddmodN(m, smulmodN(lambdaPhi, scalar{a[1], 0, 0, 0}))
+	m = saddmodN(m, smulmodN(lambdaPsi, scalar{a[2], 0, 0, 0}))
+	m = saddmodN(m, smulmodN(lambdaPhiPsi, scalar{a[3], 0, 0, 0}))
+	return
+}
+
 func recode(v scalar) (m []uint64, d []uint64) {
+	d = make([]uint64, 65)
+	m = make([]uint64, 65)
+	recodeTo(m, d, v)
+	return
+}
+
+// Same as recode, but writes the result into m and d, which must have
+// length at least 65
+func recodeTo(m, d []uint64, v scalar) {
 	bit := func(x uint64, n uint) uint64 {
 		return (x >> n) & 1
 	}
 
-	d = make([]uint64, 65)
-	m = make([]uint64, 65)
 	for i := uint(0); i < 64; i += 1 {
 		b1 := bit(v[0], i+1)
 		d[i] = 0
@@ -405,12 +498,19 @@ func recode(v scalar) (m []uint64, d []uint64) {
 
 	d[64] = v[1] + 2*v[2] + 4*v[3]
 	m[64] = 1
-	return
 }
 
 /********** Optimized multiplication **********/
 
 func tableEndo(P r1) (T []r2) {
+	T = make([]r2, 8)
+	tableEndoTo(T, P)
+	return
+}
+
+// Same as tableEndo, but writes the table into T, which must have length
+// at least 8
+func tableEndoTo(T []r2, P r1) {
 	Q1 := phi(P)
 	R1 := psi(P)
 	S1 := psi(Q1)
@@ -419,7 +519,6 @@ func tableEndo(P r1) (T []r2) {
 	R := _R1toR3(R1)
 	S := _R1toR3(S1)
 
-	T = make([]r2, 8)
 	T[0] = _R1toR2(P)                 // P
 	T[1] = _R1toR2(add_core(Q, T[0])) // P + Q
 	T[2] = _R1toR2(add_core(R, T[0])) // P + R
@@ -428,21 +527,255 @@ func tableEndo(P r1) (T []r2) {
 	T[5] = _R1toR2(add_core(S, T[1])) // P + Q + S
 	T[6] = _R1toR2(add_core(S, T[2])) // P + R + S
 	T[7] = _R1toR2(add_core(S, T[3])) // P + Q + R + S
-	return
+}
+
+// Scratch space for mulEndo, so that it can run without allocating
+type endoScratch struct {
+	T, nT [8]r2
+	s, d  [65]uint64
 }
 
 func mulEndo(m scalar, P r1, table []r2) (Q r1) {
+	var x endoScratch
+	return mulEndoCore(m, P, table, &x)
+}
+
+func mulEndoCore(m scalar, P r1, table []r2, x *endoScratch) (Q r1) {
+	T := table
+	if T == nil {
+		tableEndoTo(x.T[:], P)
+		T = x.T[:]
+	}
+	nT := x.nT[:]
+	for i, P := range T {
+		nT[i] = _R2neg(P)
+	}
+
+	// Pre-compute scalars
+	scalars := decompose(m)
+	s, d := x.s[:], x.d[:]
+	recodeTo(s, d, scalars)
+
+	// Compute the product
+	Q = _R2toR1(_R2select(s[64], T[d[64]], nT[d[64]]))
+	for i := 63; i >= 0; i -= 1 {
+		Q = dbl(Q)
+		Q = add(Q, _R2select(s[i], T[d[i]], nT[d[i]]))
+	}
+	return
+}
+
+/********** Variable-time multiplication **********/
+
+// NAF widths for variable-time multiplication.  The table for the base
+// point is precomputed, so it uses a wider NAF (and a larger table).
+const (
+	wnafWidth     = 4
+	wnafWidthBase = 8
+)
+
+// Width-w non-adjacent form of k, least significant digit first
+func wnaf(k uint64, w uint) (d []int) {
+	x := scalar{k, 0, 0, 0}
+	for x != (scalar{}) {
+		di := 0
+		if x[0]&1 == 1 {
+			di = int(x[0] % (1 << w))
+			if di >= 1<<(w-1) {
+				di -= 1 << w
+			}
+			x = ssubi(x, di)
+		}
+		d = append(d, di)
+		x = srsh(x, 1)
+	}
+	return
+}
+
+// Odd multiples of P, phi(P), psi(P), and psi(phi(P)), in four
+// consecutive tables of 2^(w-2) points each
+func tableEndoVarTime(P r1, w uint) (T []r2) {
+	n := 1 << (w - 2)
+	Q := phi(P)
+	T = make([]r2, 0, 4*n)
+	for _, R := range []r1{P, Q, psi(P), psi(Q)} {
+		T = append(T, tableOdd(R, n)...)
+	}
+	return
+}
+
+// Note: Not constant-time.  Only for use with public scalars.
+func mulEndoVarTime(m scalar, P r1, table []r2) (Q r1) {
+	T := table
+	if T == nil {
+		T = tableEndoVarTime(P, wnafWidth)
+	}
+	n := len(T) / 4
+	w := uint(2)
+	for (1 << (w - 2)) < n {
+		w += 1
+	}
+
+	// Pre-compute scalars
+	scalars := decompose(m)
+	var digits [4][]int
+	top := 0
+	for j := range digits {
+		digits[j] = wnaf(scalars[j], w)
+		if len(digits[j]) > top {
+			top = len(digits[j])
+		}
+	}
+
+	// Compute the product
+	Q = _AffineToR1(affine{Ox, Oy})
+	for i := top - 1; i >= 0; i -= 1 {
+		Q = dbl(Q)
+		for j := range digits {
+			if i >= len(digits[j]) || digits[j][i] == 0 {
+				continue
+			}
+
+			di := digits[j][i]
+			if di > 0 {
+				Q = add(Q, T[j*n+(di-1)/2])
+			} else {
+				Q = add(Q, _R2neg(T[j*n+(-di-1)/2]))
+			}
+		}
+	}
+	return
+}
+
+/********** Side-channel countermeasures **********/
+
+// Bits of randomness in the multiple of N added to blinded scalars.
+// This is the most that keeps (2^blindBits + 1)*N below 2^256, the limit
+// for decompose.
+const blindBits = 10
+
+func randWord() uint64 {
+	var buf [8]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		panic("Unable to read random bytes: " + err.Error())
+	}
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+// Returns a random non-zero field element
+func randFp2() (z fp2elt) {
+	for z == fp2Zero {
+		z[0] = fpelt{randWord(), randWord()}
+		z[1] = fpelt{randWord(), randWord()}
+		fpreduce(&z[0])
+		fpreduce(&z[1])
+	}
+	return
+}
+
+// Computes m' = (m mod N) + r*N for random r, so that [m']P = [m]P
+// for any P of order N
+func blindScalar(m scalar) scalar {
+	r := randWord() >> (64 - blindBits)
+	_, rN := smulw(N, r)
+	return sadd(smodN(m), rN)
+}
+
+// Returns (a*b*X : a*b*Y : a*b*Z : a*Ta : b*Tb) for random a, b.  This
+// represents the same point, since T = Ta*Tb = X*Y/Z.
+func randomizeR1(P r1) (Q r1) {
+	a := randFp2()
+	b := randFp2()
+	ab := fp2mul(a, b)
+	Q.X = fp2mul(ab, P.X)
+	Q.Y = fp2mul(ab, P.Y)
+	Q.Z = fp2mul(ab, P.Z)
+	Q.Ta = fp2mul(a, P.Ta)
+	Q.Tb = fp2mul(b, P.Tb)
+	return
+}
+
+// Scales each entry of the table by an independent random factor
+func randomizeTable(T []r2) (U []r2) {
+	U = make([]r2, len(T))
+	for i, P := range T {
+		z := randFp2()
+		U[i].N = fp2mul(z, P.N)
+		U[i].D = fp2mul(z, P.D)
+		U[i].E = fp2mul(z, P.E)
+		U[i].F = fp2mul(z, P.F)
+	}
+	return
+}
+
+// Note: This is a drop-in replacement for mulEndo, but only valid for P
+// of order N, since the scalar is blinded by a multiple of N.
+//
+// A table computed from a randomized point is already randomized, so
+// only a precomputed table is passed through randomizeTable.
+func mulEndoRandomized(m scalar, P r1, table []r2) r1 {
+	var T []r2
+	if table == nil {
+		T = tableEndo(randomizeR1(P))
+	} else {
+		T = randomizeTable(table)
+	}
+	return mulEndo(blindScalar(m), P, T)
+}
+
+/********** Fault-attack countermeasures **********/
+
+// Called at each point where a fault could be injected, so that tests
+// can simulate one by modifying the word passed in.  Always nil outside
+// of tests.
+var faultHook func(site string, w *uint64)
+
+func injectFault(site string, w *uint64) {
+	if faultHook != nil {
+		faultHook(site, w)
+	}
+}
+
+// Projective version of pointOnCurve, which also checks that the
+// extended coordinates are consistent:
+//
+//	(Y^2 - X^2) * Z^2 = Z^4 + d * X^2 * Y^2
+//	Ta * Tb * Z = X * Y
+func r1OnCurve(P r1) bool {
+	X2 := fp2sqr(P.X)
+	Y2 := fp2sqr(P.Y)
+	Z2 := fp2sqr(P.Z)
+	LHS := fp2mul(fp2sub(Y2, X2), Z2)
+	RHS := fp2add(fp2sqr(Z2), fp2mul(fp2mul(d, X2), Y2))
+	T := fp2mul(fp2mul(P.Ta, P.Tb), P.Z)
+	return LHS == RHS && T == fp2mul(P.X, P.Y)
+}
+
+// Number of loop iterations in mulEndoChecked between point validations
+const faultCheckInterval = 16
+
+// Same as mulEndo, but checks the decomposition of the scalar, the table,
+// and the intermediate and final points for evidence of a fault.
+func mulEndoChecked(m scalar, P r1, table []r2) (Q r1, err error) {
 	T := table
 	if T == nil {
 		T = tableEndo(P)
 	}
+	injectFault("table", &T[0].N[0][0])
 	nT := make([]r2, len(T))
 	for i, P := range T {
+		if !r1OnCurve(_R2toR1(P)) {
+			return Q, ErrFault
+		}
 		nT[i] = _R2neg(P)
 	}
 
 	// Pre-compute scalars
 	scalars := decompose(m)
+	injectFault("decompose", &scalars[1])
+	if recombine(scalars) != smodN(m) {
+		return Q, ErrFault
+	}
 	s, d := recode(scalars)
 
 	// Compute the product
@@ -450,6 +783,11 @@ func mulEndo(m scalar, P r1, table []r2) (Q r1) {
 	for i := 63; i >= 0; i -= 1 {
 		Q = dbl(Q)
 		Q = add(Q, _R2select(s[i], T[d[i]], nT[d[i]]))
+		injectFault("loop", &Q.X[0][0])
+
+		if i%faultCheckInterval == 0 && !r1OnCurve(Q) {
+			return Q, ErrFault
+		}
 	}
 	return
 }
@@ -458,31 +796,92 @@ func mulEndo(m scalar, P r1, table []r2) (Q r1) {
 
 type mulfn func(scalar, r1, []r2) r1
 
-func dhCore(m scalar, P affine, mul mulfn, table []r2) affine {
-	if !pointOnCurve(P.X, P.Y) {
-		panic("DH error: Point not on curve")
-	}
-
-	P0 := _AffineToR1(P)
+// [392]P = [256]P + [128]P + [8]P
+func mulCofactor(P0 r1) r1 {
 	P1 := dbl(dbl(dbl(P0)))
 	P2 := dbl(dbl(dbl(dbl(P1))))
 	P3 := dbl(P2)
 	P3 = add(P3, _R1toR2(P2))
 	P3 = add(P3, _R1toR2(P1))
-	Q := _R1toAffine(mul(m, P3, table))
+	return P3
+}
+
+// Computes [392*m]P, returning ErrInvalidPoint if P is not on the curve,
+// and ErrNeutralPoint if the result is the neutral point, as it is when
+// P has small order or m is a multiple of N
+func dhCore(m scalar, P affine, mul mulfn, table []r2) (affine, error) {
+	if !pointOnCurve(P.X, P.Y) {
+		return affine{}, ErrInvalidPoint
+	}
+
+	Q := _R1toAffine(dhProjective(m, P, mul, table))
+
+	O := affine{Ox, Oy}
+	if Q == O {
+		return affine{}, ErrNeutralPoint
+	}
+
+	return Q, nil
+}
+
+// The multiplication in dhCore, without the conversion to affine
+// coordinates, so that callers can share one inversion among several
+// results
+func dhProjective(m scalar, P affine, mul mulfn, table []r2) r1 {
+	return mul(m, mulCofactor(_AffineToR1(P)), table)
+}
+
+// Same as dhEndo, but returns ErrFault instead of a result if a fault
+// is detected.  If randomize is set, then the countermeasures of
+// mulEndoRandomized are also applied.
+func dhChecked(m scalar, P affine, table []r2, randomize bool) (affine, error) {
+	if !pointOnCurve(P.X, P.Y) {
+		return affine{}, ErrInvalidPoint
+	}
+
+	P3 := mulCofactor(_AffineToR1(P))
+	injectFault("cofactor", &P3.X[0][0])
+	if !r1OnCurve(P3) {
+		return affine{}, ErrFault
+	}
+
+	T := table
+	if randomize {
+		if table == nil {
+			T = tableEndo(randomizeR1(P3))
+		} else {
+			T = randomizeTable(table)
+		}
+		m = blindScalar(m)
+	}
+
+	R, err := mulEndoChecked(m, P3, T)
+	if err != nil {
+		return affine{}, err
+	}
+
+	Q := _R1toAffine(R)
+	injectFault("result", &Q.X[0][0])
+	if !pointOnCurve(Q.X, Q.Y) {
+		return affine{}, ErrFault
+	}
 
 	O := affine{Ox, Oy}
 	if Q == O {
-		panic("DH computation resulted in neutral point")
+		return affine{}, ErrNeutralPoint
 	}
 
-	return Q
+	return Q, nil
 }
 
-func dhWindowed(m scalar, P affine, table []r2) affine {
+func dhWindowed(m scalar, P affine, table []r2) (affine, error) {
 	return dhCore(m, P, mulWindowed, table)
 }
 
-func dhEndo(m scalar, P affine, table []r2) affine {
+func dhEndo(m scalar, P affine, table []r2) (affine, error) {
 	return dhCore(m, P, mulEndo, table)
 }
+
+func dhVarTime(m scalar, P affine, table []r2) (affine, error) {
+	return dhCore(m, P, mulEndoVarTime, table)
+}
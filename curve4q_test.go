@@ -48,10 +48,24 @@ func TestEncodeDecode(t *testing.T) {
 		t.Fatalf("Encode test failed")
 	}
 
-	dec := decode(GEnc)
-	if dec.X != Gx || dec.Y != Gy {
+	dec, err := decode(GEnc)
+	if err != nil || dec.X != Gx || dec.Y != Gy {
 		t.Fatalf("Decode test failed")
 	}
+
+	// Points with both signs of x should survive a round trip
+	P := _AffineToR1(affine{Gx, Gy})
+	G2 := _R1toR2(P)
+	for i := 0; i < 100; i += 1 {
+		P = add(P, G2)
+		Q := _R1toAffine(P)
+		for _, R := range []affine{Q, {fp2neg(Q.X), Q.Y}} {
+			dec, err = decode(encode(R))
+			if err != nil || dec != R {
+				t.Fatalf("Round-trip test failed")
+			}
+		}
+	}
 }
 
 func TestReps(t *testing.T) {
@@ -204,17 +218,41 @@ func TestMulWindowed(t *testing.T) {
 		t.Fatalf("failed large multiply test (windowed)")
 	}
 
-	// Test multiplication test with fixed base
-	table := tableWindowed(A)
-	B = mulWindowed(toScalar(1), A, table)
-	if _R1toAffine(A) != _R1toAffine(B) {
-		t.Fatalf("failed multiply-by-1 test (windowed, fixed base)")
+	// Test multiplication test with fixed base, for each window width
+	for w := uint(windowMin); w <= windowMax; w += 1 {
+		table := tableWindowed(A, w)
+		if len(table) != 1<<(w-2) {
+			t.Fatalf("failed table size test (windowed, w=%d)", w)
+		}
+
+		B = mulWindowed(toScalar(1), A, table)
+		if _R1toAffine(A) != _R1toAffine(B) {
+			t.Fatalf("failed multiply-by-1 test (windowed, fixed base, w=%d)", w)
+		}
+
+		A2 = dbl(A)
+		B2 = mulWindowed(toScalar(2), A, table)
+		if _R1toAffine(A2) != _R1toAffine(B2) {
+			t.Fatalf("failed multiply-by-2 test (windowed, fixed base, w=%d)", w)
+		}
+
+		for i := 0; i < 10; i += 1 {
+			m := randScalar()
+			if _R1toAffine(mulWindowed(m, A, table)) != _R1toAffine(mulEndo(m, A, nil)) {
+				t.Fatalf("failed random multiply test (windowed, fixed base, w=%d)", w)
+			}
+		}
+
+		// Largest scalar supported by mulWindowedCore
+		m := ssub(scalar{0, 0, 0, 1 << (windowBits - 192)}, N)
+		m = ssub(m, toScalar(1))
+		if _R1toAffine(mulWindowedCore(m, table)) != _R1toAffine(mulEndo(m, A, nil)) {
+			t.Fatalf("failed large multiply test (windowed, fixed base, w=%d)", w)
+		}
 	}
 
-	A2 = dbl(A)
-	B2 = mulWindowed(toScalar(2), A, table)
-	if _R1toAffine(A2) != _R1toAffine(B2) {
-		t.Fatalf("failed multiply-by-2 test (windowed, fixed base)")
+	if windowWidth(false) != 6 || windowWidth(true) != 7 {
+		t.Fatalf("failed window width test")
 	}
 }
 
@@ -395,7 +433,19 @@ func TestMulEndo(t *testing.T) {
 func TestDH(t *testing.T) {
 	TEST_LOOPS := 100
 
-	dhTest := func(label string, dh func(m scalar, P affine, table []r2) affine) {
+	type dhfn func(m scalar, P affine, table []r2) (affine, error)
+	must := func(Q affine, err error) affine {
+		if err != nil {
+			t.Fatalf("failed DH test: %v", err)
+		}
+		return Q
+	}
+
+	dhTest := func(label string, dhErr dhfn) {
+		dh := func(m scalar, P affine, table []r2) affine {
+			return must(dhErr(m, P, table))
+		}
+
 		// Test that DH(m, P) == [392*m]P
 		P := affine{Gx, Gy}
 		for i := 0; i < TEST_LOOPS; i += 1 {
@@ -425,11 +475,11 @@ func TestDH(t *testing.T) {
 	dhTest("windowed", dhWindowed)
 	dhTest("endo", dhEndo)
 
-	dhTestFixed := func(label string, dh func(m scalar, P affine, table []r2) affine, P affine, table []r2) {
+	dhTestFixed := func(label string, dh dhfn, P affine, table []r2) {
 		for i := 0; i < TEST_LOOPS; i += 1 {
 			m := randScalar()
-			Q1 := dh(m, P, table)
-			Q2 := dh(m, P, nil)
+			Q1 := must(dh(m, P, table))
+			Q2 := must(dh(m, P, nil))
 			if Q1 != Q2 {
 				t.Fatalf("failed DH 392*m test (%s)", label)
 			}
@@ -438,8 +488,291 @@ func TestDH(t *testing.T) {
 
 	G := affine{Gx, Gy}
 	G392 := mulEndo(toScalar(392), _AffineToR1(G), nil)
-	Twin := tableWindowed(G392)
+	Twin := tableWindowed(G392, windowWidth(true))
 	Tendo := tableEndo(G392)
 	dhTestFixed("windowed", dhWindowed, G, Twin)
 	dhTestFixed("endo", dhEndo, G, Tendo)
 }
+
+func TestRandomized(t *testing.T) {
+	TEST_LOOPS := 20
+
+	P := _AffineToR1(affine{Gx, Gy})
+	if _R1toAffine(randomizeR1(P)) != _R1toAffine(P) {
+		t.Fatalf("failed randomizeR1 test")
+	}
+	if _R1toAffine(add(randomizeR1(P), _R1toR2(P))) != _R1toAffine(dbl(P)) {
+		t.Fatalf("failed randomizeR1 extended coordinate test")
+	}
+
+	T := tableEndo(P)
+	U := randomizeTable(T)
+	for i := range T {
+		if _R1toAffine(_R2toR1(T[i])) != _R1toAffine(_R2toR1(U[i])) {
+			t.Fatalf("failed randomizeTable test")
+		}
+	}
+
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		m := randScalar()
+		if smodN(blindScalar(m)) != smodN(m) {
+			t.Fatalf("failed blindScalar test")
+		}
+	}
+
+	// Blinded scalars are less than (2^blindBits + 1)*N, which must fit
+	// in the 256 bits handled by decompose
+	if c, _ := smulw(N, 1<<blindBits+1); c != 0 {
+		t.Fatalf("failed blindScalar range test")
+	}
+
+	randomizedTest := func(label string, mul, mulRand mulfn, table []r2) {
+		for i := 0; i < TEST_LOOPS; i += 1 {
+			m := randScalar()
+			Q1 := _R1toAffine(mul(m, P, table))
+			Q2 := _R1toAffine(mulRand(m, P, table))
+			if Q1 != Q2 {
+				t.Fatalf("failed randomized multiplication test (%s)", label)
+			}
+		}
+	}
+
+	randomizedTest("endo", mulEndo, mulEndoRandomized, nil)
+	randomizedTest("endo, fixed base", mulEndo, mulEndoRandomized, tableEndo(P))
+}
+
+func TestEigenvalues(t *testing.T) {
+	P := _AffineToR1(affine{Gx, Gy})
+	P392 := mulCofactor(P)
+
+	for _, Q := range []r1{P, P392} {
+		Q1 := _R1toAffine(phi(Q))
+		Q2 := _R1toAffine(mulWindowed(lambdaPhi, Q, nil))
+		if Q1 != Q2 {
+			t.Fatalf("failed phi eigenvalue test")
+		}
+
+		Q1 = _R1toAffine(psi(Q))
+		Q2 = _R1toAffine(mulWindowed(lambdaPsi, Q, nil))
+		if Q1 != Q2 {
+			t.Fatalf("failed psi eigenvalue test")
+		}
+
+		Q1 = _R1toAffine(psi(phi(Q)))
+		Q2 = _R1toAffine(mulWindowed(lambdaPhiPsi, Q, nil))
+		if Q1 != Q2 {
+			t.Fatalf("failed psi*phi eigenvalue test")
+		}
+	}
+
+	if smulmodN(lambdaPhi, lambdaPsi) != lambdaPhiPsi {
+		t.Fatalf("failed eigenvalue product test")
+	}
+
+	TEST_LOOPS := 100
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		m := randScalar()
+		if recombine(decompose(m)) != smodN(m) {
+			t.Fatalf("failed recombine test")
+		}
+	}
+}
+
+func TestFaults(t *testing.T) {
+	G := affine{Gx, Gy}
+	G392 := mulCofactor(_AffineToR1(G))
+	m := randScalar()
+	expected, _ := dhEndo(m, G, nil)
+
+	Q, err := dhChecked(m, G, nil, false)
+	if err != nil || Q != expected {
+		t.Fatalf("failed fault detection test (no fault): %v", err)
+	}
+
+	// Flip one bit in the word passed at the n-th call for the given site
+	defer func() { faultHook = nil }()
+	for _, site := range []string{"table", "decompose", "loop", "cofactor", "result"} {
+		for _, n := range []int{0, 17, 63} {
+			if n > 0 && site != "loop" {
+				continue
+			}
+
+			calls := 0
+			faultHook = func(s string, w *uint64) {
+				if s == site {
+					if calls == n {
+						*w ^= 1 << 12
+					}
+					calls += 1
+				}
+			}
+
+			for _, randomize := range []bool{false, true} {
+				calls = 0
+				_, err = dhChecked(m, G, tableEndo(G392), randomize)
+				if err != ErrFault {
+					t.Fatalf("failed fault detection test (%s, %d, %v)", site, n, randomize)
+				}
+			}
+		}
+	}
+}
+
+func TestWNAF(t *testing.T) {
+	TEST_LOOPS := 1000
+
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		k := randScalar()[0] << 1 >> uint(i%2)
+		for _, w := range []uint{2, wnafWidth, wnafWidthBase} {
+			d := wnaf(k, w)
+
+			var x scalar
+			for j := len(d) - 1; j >= 0; j -= 1 {
+				x = sadd(x, x)
+				x = ssubi(x, -d[j])
+
+				if d[j] != 0 && (d[j]%2 == 0 || abs(d[j]) >= 1<<(w-1)) {
+					t.Fatalf("failed wNAF digit test")
+				}
+				for l := 1; l < int(w) && d[j] != 0 && j+l < len(d); l += 1 {
+					if d[j+l] != 0 {
+						t.Fatalf("failed wNAF non-adjacency test")
+					}
+				}
+			}
+
+			if x != (scalar{k, 0, 0, 0}) {
+				t.Fatalf("failed wNAF recombination test")
+			}
+		}
+	}
+}
+
+func TestMulEndoVarTime(t *testing.T) {
+	TEST_LOOPS := 100
+
+	A := _AffineToR1(affine{Gx, Gy})
+	for _, w := range []uint{wnafWidth, wnafWidthBase} {
+		table := tableEndoVarTime(A, w)
+		for i := 0; i < TEST_LOOPS; i += 1 {
+			m := randScalar()
+			if i < 3 {
+				m = toScalar(uint64(i))
+			}
+
+			Q1 := _R1toAffine(mulEndo(m, A, nil))
+			Q2 := _R1toAffine(mulEndoVarTime(m, A, table))
+			if Q1 != Q2 {
+				t.Fatalf("failed variable-time multiply test (w=%d)", w)
+			}
+		}
+	}
+
+	if !testMul(mulEndoVarTime, nil) {
+		t.Fatalf("failed large multiply test (variable-time)")
+	}
+}
+
+/********** Benchmarks **********/
+
+var (
+	benchR1     r1
+	benchAffine affine
+	benchScalar scalar
+	benchBytes  []byte
+	benchErr    error
+)
+
+// Points and scalars for benchmarks
+func benchInputs(n int) (points []r1, scalars []scalar) {
+	points = make([]r1, n)
+	scalars = make([]scalar, n)
+	for i := range points {
+		scalars[i] = smodN(randScalar())
+		points[i] = mulWindowed(scalars[i], G1, nil)
+	}
+	return
+}
+
+func BenchmarkEncode(b *testing.B) {
+	points, _ := benchInputs(16)
+	benchOps(b, func(i int) { benchBytes = encode(_R1toAffine(points[i%16])) })
+}
+
+func BenchmarkDecode(b *testing.B) {
+	points, _ := benchInputs(16)
+	bufs := make([][]byte, len(points))
+	for i, P := range points {
+		bufs[i] = encode(_R1toAffine(P))
+	}
+	benchOps(b, func(i int) { benchAffine, benchErr = decode(bufs[i%16]) })
+}
+
+func BenchmarkR1toAffine(b *testing.B) {
+	points, _ := benchInputs(16)
+	benchOps(b, func(i int) { benchAffine = _R1toAffine(points[i%16]) })
+}
+
+func BenchmarkDbl(b *testing.B) {
+	points, _ := benchInputs(16)
+	benchOps(b, func(i int) { benchR1 = dbl(points[i%16]) })
+}
+
+func BenchmarkAdd(b *testing.B) {
+	points, _ := benchInputs(16)
+	Q := _R1toR2(points[0])
+	benchOps(b, func(i int) { benchR1 = add(points[i%16], Q) })
+}
+
+func BenchmarkAddCore(b *testing.B) {
+	points, _ := benchInputs(16)
+	P, Q := _R1toR3(points[0]), _R1toR2(points[1])
+	benchOps(b, func(i int) { benchR1 = add_core(P, Q) })
+}
+
+func BenchmarkPhi(b *testing.B) {
+	points, _ := benchInputs(16)
+	benchOps(b, func(i int) { benchR1 = phi(points[i%16]) })
+}
+
+func BenchmarkPsi(b *testing.B) {
+	points, _ := benchInputs(16)
+	benchOps(b, func(i int) { benchR1 = psi(points[i%16]) })
+}
+
+func BenchmarkMulCofactor(b *testing.B) {
+	points, _ := benchInputs(16)
+	benchOps(b, func(i int) { benchR1 = mulCofactor(points[i%16]) })
+}
+
+func BenchmarkDecompose(b *testing.B) {
+	_, scalars := benchInputs(16)
+	benchOps(b, func(i int) { benchScalar = decompose(scalars[i%16]) })
+}
+
+func BenchmarkRecode(b *testing.B) {
+	_, scalars := benchInputs(16)
+	var s, d [65]uint64
+	benchOps(b, func(i int) { recodeTo(s[:], d[:], decompose(scalars[i%16])) })
+}
+
+func BenchmarkTableEndo(b *testing.B) {
+	points, _ := benchInputs(16)
+	var T [8]r2
+	benchOps(b, func(i int) { tableEndoTo(T[:], points[i%16]) })
+}
+
+func BenchmarkMulWindowed(b *testing.B) {
+	points, scalars := benchInputs(16)
+	benchOps(b, func(i int) { benchR1 = mulWindowed(scalars[i%16], points[i%16], nil) })
+}
+
+func BenchmarkMulEndo(b *testing.B) {
+	points, scalars := benchInputs(16)
+	benchOps(b, func(i int) { benchR1 = mulEndo(scalars[i%16], points[i%16], nil) })
+}
+
+func BenchmarkMulEndoVarTime(b *testing.B) {
+	points, scalars := benchInputs(16)
+	benchOps(b, func(i int) { benchR1 = mulEndoVarTime(scalars[i%16], points[i%16], nil) })
+}
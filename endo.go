@@ -0,0 +1,84 @@
+package curve4q
+
+import (
+	"encoding/binary"
+)
+
+func encodeScalar(m scalar) (out [32]byte) {
+	binary.LittleEndian.PutUint64(out[0:8], m[0])
+	binary.LittleEndian.PutUint64(out[8:16], m[1])
+	binary.LittleEndian.PutUint64(out[16:24], m[2])
+	binary.LittleEndian.PutUint64(out[24:32], m[3])
+	return
+}
+
+// The formulas for phi and psi are not complete: tau sends the four
+// points of order 8 with x^2 = -y^2 to points with Z = 0, which upsilon and
+// chi do not handle.  The scalar multiplications only apply the
+// endomorphisms to points whose cofactor has been cleared.
+func endoExceptional(P r1) bool {
+	return tau(_R1toR4(P)).Z == fp2Zero
+}
+
+func endoApply(dst, base *[32]byte, f func(r1) r1) error {
+	P, err := decode(base[:])
+	if err != nil {
+		return err
+	}
+
+	P1 := _AffineToR1(P)
+	if endoExceptional(P1) {
+		return ErrPointAtInfinity
+	}
+	Q := _R1toAffine(f(P1))
+	encodeTo(dst[:], Q)
+	return nil
+}
+
+// Phi computes phi(P) for the point P encoded in base.  It returns
+// ErrPointAtInfinity for the four points of order 8 that the formulas
+// send through the point at infinity of an isogenous curve.
+func Phi(dst, base *[32]byte) error {
+	return endoApply(dst, base, phi)
+}
+
+// Psi computes psi(P) for the point P encoded in base, and fails for the
+// same points as Phi.
+func Psi(dst, base *[32]byte) error {
+	return endoApply(dst, base, psi)
+}
+
+// Eigenvalues returns the eigenvalues of phi and psi on the subgroup of
+// order N, as little-endian integers modulo N.  The eigenvalue of
+// psi(phi(P)) is their product.  These are what allow the decomposition
+// of scalars: for P in the subgroup,
+//
+//	[k]P = [a0]P + [a1]phi(P) + [a2]psi(P) + [a3]psi(phi(P))
+//
+// where (a0, a1, a2, a3) = Decompose(k).
+func Eigenvalues() (lphi, lpsi [32]byte) {
+	return encodeScalar(lambdaPhi), encodeScalar(lambdaPsi)
+}
+
+// Decompose reduces the little-endian scalar k modulo N, and splits the
+// result into four 64-bit coefficients a such that
+//
+//	k = a[0] + a[1]*lphi + a[2]*lpsi + a[3]*lphi*lpsi (mod N)
+//
+// with a[0] odd.
+func Decompose(k *[32]byte) (a [4]uint64) {
+	return [4]uint64(decompose(sreduce(k[:])))
+}
+
+// Recode converts a decomposition returned by Decompose into the 65
+// signed digits that ScalarMult processes, most significant last.  Digit
+// i is the table entry
+//
+//	P + b0*phi(P) + b1*psi(P) + b2*psi(phi(P))
+//
+// where b0, b1 and b2 are the bits of d[i], negated if s[i] is zero, so
+// that a[j] is the sum of the corresponding coefficients times 2^i.
+func Recode(a [4]uint64) (s, d [65]uint64) {
+	recodeTo(s[:], d[:], scalar(a))
+	return
+}
@@ -0,0 +1,175 @@
+package curve4q
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/bifurcation/curve4q/internal/params"
+)
+
+// Evaluates the formulas in decompose over the integers, so that any
+// wraparound in the 64-bit arithmetic shows up as a coefficient outside
+// of [0, 2^64)
+func decomposeBig(m scalar) (a [4]*big.Int) {
+	mb := scalarToBigInt(m)
+	t := make([]*big.Int, 4)
+	for i, L := range []scalar{L1, L2, L3, L4} {
+		t[i] = big.NewInt(0).Mul(mb, scalarToBigInt(L))
+		t[i].Rsh(t[i], 256)
+	}
+
+	v := params.DecomposeBasis([4][4]uint64{b1, b2, b3, b4})
+	for j := 0; j < 4; j += 1 {
+		a[j] = big.NewInt(0).SetUint64(c[j])
+		for i := 0; i < 4; i += 1 {
+			a[j].Add(a[j], big.NewInt(0).Mul(t[i], v[i][j]))
+		}
+	}
+	a[0].Add(a[0], mb)
+
+	if a[0].Bit(0) == 0 {
+		for j := range a {
+			a[j].Sub(a[j], v[3][j])
+		}
+	}
+	return
+}
+
+func TestDecomposeBounds(t *testing.T) {
+	TEST_LOOPS := 1000
+
+	one := scalar{1, 0, 0, 0}
+	Nm1 := ssubi(N, 1)
+	max256 := scalar{_m, _m, _m, _m}
+	inputs := []scalar{{0, 0, 0, 0}, one, Nm1, N, max256}
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		m := randScalar()
+		m[3] |= uint64(i&1) << 63
+		inputs = append(inputs, m, smodN(m))
+	}
+
+	bound := big.NewInt(0).Lsh(big.NewInt(1), 64)
+	for _, m := range inputs {
+		a := decompose(m)
+		ab := decomposeBig(m)
+		for j := range a {
+			if ab[j].Sign() < 0 || ab[j].Cmp(bound) >= 0 {
+				t.Fatalf("failed decompose bounds test: a[%d] = %v for m = %v", j, ab[j], m)
+			}
+			if ab[j].Uint64() != a[j] {
+				t.Fatalf("failed decompose reference test: a[%d] for m = %v", j, m)
+			}
+		}
+		if a[0]&1 != 1 {
+			t.Fatalf("failed decompose parity test for m = %v", m)
+		}
+	}
+}
+
+func TestEndoAPI(t *testing.T) {
+	TEST_LOOPS := 20
+
+	var G [32]byte
+	copy(G[:], encode(affine{Gx, Gy}))
+	lphi, lpsi := Eigenvalues()
+
+	P := G
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var k, Q1, Q2 [32]byte
+		for j := range k {
+			k[j] = byte(i*31 + j*7)
+		}
+		ScalarMult(&P, &k, &P)
+
+		// ScalarMultWin includes the cofactor, so compare against phi
+		// and psi of [392]P
+		var R [32]byte
+		ScalarMultWin(&R, []byte{1}, &P, 0)
+		ScalarMultWin(&Q2, lphi[:], &P, 0)
+		if err := Phi(&Q1, &R); err != nil || Q1 != Q2 {
+			t.Fatalf("failed Phi eigenvalue test: %v", err)
+		}
+
+		ScalarMultWin(&Q2, lpsi[:], &P, 0)
+		if err := Psi(&Q1, &R); err != nil || Q1 != Q2 {
+			t.Fatalf("failed Psi eigenvalue test: %v", err)
+		}
+
+		a := Decompose(&k)
+		lphiS, lpsiS := sreduce(lphi[:]), sreduce(lpsi[:])
+		m := scalar{a[0], 0, 0, 0}
+		m = saddmodN(m, smulmodN(lphiS, scalar{a[1], 0, 0, 0}))
+		m = saddmodN(m, smulmodN(lpsiS, scalar{a[2], 0, 0, 0}))
+		m = saddmodN(m, smulmodN(smulmodN(lphiS, lpsiS), scalar{a[3], 0, 0, 0}))
+		if m != sreduce(k[:]) {
+			t.Fatalf("failed Decompose test")
+		}
+
+		s, d := Recode(a)
+		for j := 0; j < 4; j += 1 {
+			aj := new(big.Int)
+			for i := 64; i >= 0; i -= 1 {
+				aj.Lsh(aj, 1)
+				if j > 0 && (d[i]>>uint(j-1))&1 == 0 {
+					continue
+				}
+				if s[i] == 1 {
+					aj.Add(aj, big.NewInt(1))
+				} else {
+					aj.Sub(aj, big.NewInt(1))
+				}
+			}
+			if !aj.IsUint64() || aj.Uint64() != a[j] {
+				t.Fatalf("failed Recode test")
+			}
+		}
+	}
+
+	var bad [32]byte
+	bad[15] = 0x80
+	if Phi(&bad, &bad) != ErrInvalidPoint || Psi(&bad, &bad) != ErrInvalidPoint {
+		t.Fatalf("failed endomorphism invalid point test")
+	}
+
+	// phi and psi map the small-order points to small-order points, except
+	// for the exceptional points, which must be rejected rather than
+	// encoded as all zeros
+	exceptional := 0
+	for _, S := range smallOrderPoints {
+		in := [32]byte(encode(S))
+		for _, f := range []func(dst, base *[32]byte) error{Phi, Psi} {
+			var Q [32]byte
+			err := f(&Q, &in)
+			if endoExceptional(_AffineToR1(S)) {
+				if err != ErrPointAtInfinity {
+					t.Fatalf("failed endomorphism exceptional point test: %v", err)
+				}
+				exceptional += 1
+				continue
+			}
+
+			Qp, err2 := DecodePoint(&Q)
+			if err != nil || err2 != nil || !Qp.IsSmallOrder() {
+				t.Fatalf("failed endomorphism small order test: %v, %v", err, err2)
+			}
+		}
+	}
+	if exceptional != 8 {
+		t.Fatalf("failed endomorphism exceptional point count test: %d", exceptional)
+	}
+}
+
+func BenchmarkPhiAPI(b *testing.B) {
+	_, P := benchAPIInputs(16)
+	benchOps(b, func(i int) { Phi(&benchPoint, &P[i%16]) })
+}
+
+func BenchmarkPsiAPI(b *testing.B) {
+	_, P := benchAPIInputs(16)
+	benchOps(b, func(i int) { Psi(&benchPoint, &P[i%16]) })
+}
+
+func BenchmarkDecomposeAPI(b *testing.B) {
+	m, _ := benchAPIInputs(16)
+	benchOps(b, func(i int) { Decompose(&m[i%16]) })
+}
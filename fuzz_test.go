@@ -0,0 +1,115 @@
+package curve4q
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"testing"
+)
+
+// Fuzz targets with differential oracles.  The seed corpus lives in
+// testdata/fuzz; run e.g. `go test -fuzz=FuzzDecode` to explore further.
+
+func FuzzDecode(f *testing.F) {
+	G := encode(affine{Gx, Gy})
+	f.Add(G)
+
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		var P affine
+		var err error
+		if panics(func() { P, err = decode(buf) }) {
+			t.Fatalf("failed decode no-panic test")
+		}
+		if err != nil {
+			return
+		}
+
+		if !pointOnCurve(P.X, P.Y) {
+			t.Fatalf("failed decode on-curve test")
+		}
+
+		if !bytes.Equal(encode(P), buf) {
+			t.Fatalf("failed decode round-trip test")
+		}
+	})
+}
+
+func FuzzScalarMult(f *testing.F) {
+	G := encode(affine{Gx, Gy})
+	f.Add(make([]byte, 32), G)
+
+	f.Fuzz(func(t *testing.T, in, base []byte) {
+		var m, B [32]byte
+		copy(m[:], in)
+		copy(B[:], base)
+
+		// Fall back to the generator, so that every input exercises the
+		// multiplication
+		P, err := decode(B[:])
+		if err != nil {
+			P = affine{Gx, Gy}
+			B = [32]byte(G)
+		}
+
+		var Q1 affine
+		var Q2 [32]byte
+		Q1, err = dhWindowed(decodeScalar(&m), P, nil)
+		var err2 error
+		if panics(func() { err2 = ScalarMult(&Q2, &m, &B) }) {
+			t.Fatalf("failed ScalarMult no-panic test")
+		}
+		if err != err2 {
+			t.Fatalf("failed ScalarMult neutral test")
+		}
+		if err == nil && [32]byte(encode(Q1)) != Q2 {
+			t.Fatalf("failed ScalarMult differential test")
+		}
+	})
+}
+
+func FuzzDecompose(f *testing.F) {
+	f.Add(make([]byte, 32))
+
+	f.Fuzz(func(t *testing.T, in []byte) {
+		var buf [32]byte
+		copy(buf[:], in)
+		var m scalar
+		for i := range m {
+			m[i] = binary.LittleEndian.Uint64(buf[8*i:])
+		}
+
+		a := decompose(m)
+		if a[0]&1 != 1 {
+			t.Fatalf("failed decompose parity test")
+		}
+		if recombine(a) != smodN(m) {
+			t.Fatalf("failed decompose recombination test")
+		}
+
+		// Recombine the recoded digits into the four sub-scalars.  Digit i
+		// contributes +/- 2^i to a[0], and to a[j] if bit j-1 of d[i] is set.
+		s, d := recode(a)
+		var sum [4]*big.Int
+		for j := range sum {
+			sum[j] = big.NewInt(0)
+		}
+		for i := len(d) - 1; i >= 0; i -= 1 {
+			for j := range sum {
+				sum[j].Lsh(sum[j], 1)
+				if j > 0 && (d[i]>>uint(j-1))&1 == 0 {
+					continue
+				}
+				if s[i] == 1 {
+					sum[j].Add(sum[j], big.NewInt(1))
+				} else {
+					sum[j].Sub(sum[j], big.NewInt(1))
+				}
+			}
+		}
+		for j := range sum {
+			if sum[j].Cmp(new(big.Int).SetUint64(a[j])) != 0 {
+				t.Fatalf("failed recode recombination test")
+			}
+		}
+	})
+}
@@ -0,0 +1,229 @@
+package curve4q
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+// Property-based tests of the group law, using testing/quick.  Points are
+// drawn from a mix of exceptional points (the neutral point, the point of
+// order 2, and other points of small order), points of order N, and
+// points with both components, and are given a random Z coordinate.
+
+// A point in the subgroup of order N
+type quickPoint struct{ P r1 }
+
+// An arbitrary point on the curve, including the exceptional points
+type quickAnyPoint struct{ P r1 }
+
+type quickScalar struct{ m scalar }
+
+func randFp2Quick(r *rand.Rand) fp2elt {
+	x := fp2elt{fpelt{r.Uint64(), r.Uint64()}, fpelt{r.Uint64(), r.Uint64()}}
+	fpreduce(&x[0])
+	fpreduce(&x[1])
+	return x
+}
+
+func randScalarQuick(r *rand.Rand) scalar {
+	m := scalar{r.Uint64(), r.Uint64(), r.Uint64(), r.Uint64()}
+	return smodN(scalar{m[0], m[1], m[2], m[3] & 0x003fffffffffffff})
+}
+
+// Multiplies the coordinates of P by a random z, which leaves the point
+// unchanged
+func rescaleR1(r *rand.Rand, P r1) r1 {
+	z := randFp2Quick(r)
+	if z == fp2Zero {
+		z = fp2One
+	}
+	return r1{fp2mul(z, P.X), fp2mul(z, P.Y), fp2mul(z, P.Z), fp2mul(z, P.Ta), P.Tb}
+}
+
+func randSubgroupPoint(r *rand.Rand) r1 {
+	return mulWindowed(randScalarQuick(r), G1, nil)
+}
+
+func (quickPoint) Generate(r *rand.Rand, size int) reflect.Value {
+	P := G1
+	if r.Intn(8) != 0 {
+		P = randSubgroupPoint(r)
+	}
+	return reflect.ValueOf(quickPoint{rescaleR1(r, P)})
+}
+
+func (quickAnyPoint) Generate(r *rand.Rand, size int) reflect.Value {
+	var P r1
+	switch r.Intn(8) {
+	case 0:
+		P = O1
+	case 1:
+		P = _AffineToR1(affine{fp2Zero, fp2neg(fp2One)})
+	case 2, 3:
+		P = _AffineToR1(smallOrderPoints[r.Intn(len(smallOrderPoints))])
+	case 4:
+		P = randSubgroupPoint(r)
+	default:
+		T := smallOrderPoints[r.Intn(len(smallOrderPoints))]
+		P = add(randSubgroupPoint(r), _R1toR2(_AffineToR1(T)))
+	}
+	return reflect.ValueOf(quickAnyPoint{rescaleR1(r, P)})
+}
+
+func (quickScalar) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(quickScalar{randScalarQuick(r)})
+}
+
+func r1Equal(P, Q r1) bool {
+	return _R1toAffine(P) == _R1toAffine(Q)
+}
+
+func r1Neg(P r1) r1 {
+	return r1{fp2neg(P.X), P.Y, P.Z, fp2neg(P.Ta), P.Tb}
+}
+
+func quickCheck(t *testing.T, name string, f interface{}) {
+	TEST_LOOPS := 50
+
+	if err := quick.Check(f, &quick.Config{MaxCount: TEST_LOOPS}); err != nil {
+		t.Fatalf("failed %s test: %v", name, err)
+	}
+}
+
+func TestGroupLaw(t *testing.T) {
+	quickCheck(t, "closure", func(P, Q quickAnyPoint) bool {
+		return r1OnCurve(add(P.P, _R1toR2(Q.P))) && r1OnCurve(dbl(P.P))
+	})
+
+	quickCheck(t, "identity", func(P quickAnyPoint) bool {
+		return r1Equal(add(P.P, _R1toR2(O1)), P.P) && r1Equal(add(O1, _R1toR2(P.P)), P.P)
+	})
+
+	quickCheck(t, "inverse", func(P quickAnyPoint) bool {
+		return r1Equal(add(P.P, _R2neg(_R1toR2(P.P))), O1) && r1Equal(add(P.P, _R1toR2(r1Neg(P.P))), O1)
+	})
+
+	quickCheck(t, "commutativity", func(P, Q quickAnyPoint) bool {
+		return r1Equal(add(P.P, _R1toR2(Q.P)), add(Q.P, _R1toR2(P.P)))
+	})
+
+	quickCheck(t, "associativity", func(P, Q, R quickAnyPoint) bool {
+		PQ := add(P.P, _R1toR2(Q.P))
+		QR := add(Q.P, _R1toR2(R.P))
+		return r1Equal(add(PQ, _R1toR2(R.P)), add(P.P, _R1toR2(QR)))
+	})
+
+	quickCheck(t, "doubling", func(P quickAnyPoint) bool {
+		return r1Equal(dbl(P.P), add(P.P, _R1toR2(P.P)))
+	})
+
+	quickCheck(t, "add_core", func(P, Q quickAnyPoint) bool {
+		return r1Equal(add_core(_R1toR3(P.P), _R1toR2(Q.P)), add(P.P, _R1toR2(Q.P)))
+	})
+
+	quickCheck(t, "mulCofactor", func(P quickAnyPoint) bool {
+		Q := P.P
+		for i := 1; i < cofactor; i += 1 {
+			Q = add(Q, _R1toR2(P.P))
+		}
+		return r1Equal(mulCofactor(P.P), Q)
+	})
+}
+
+func TestRepresentations(t *testing.T) {
+	quickCheck(t, "affine", func(P quickAnyPoint) bool {
+		A := _R1toAffine(P.P)
+		return r1Equal(_AffineToR1(A), P.P) && pointOnCurve(A.X, A.Y)
+	})
+
+	quickCheck(t, "r2", func(P quickAnyPoint) bool {
+		Q := _R1toR2(P.P)
+		return r1Equal(_R2toR1(Q), P.P) && _R4toAffine(_R2toR4(Q)) == _R1toAffine(P.P)
+	})
+
+	quickCheck(t, "r2 negation", func(P quickAnyPoint) bool {
+		return r1Equal(_R2toR1(_R2neg(_R1toR2(P.P))), r1Neg(P.P))
+	})
+
+	quickCheck(t, "r2 select", func(P, Q quickAnyPoint) bool {
+		A, B := _R1toR2(P.P), _R1toR2(Q.P)
+		return _R2select(1, A, B) == A && _R2select(0, A, B) == B
+	})
+
+	quickCheck(t, "r4", func(P quickAnyPoint) bool {
+		return _R4toAffine(_R1toR4(P.P)) == _R1toAffine(P.P)
+	})
+}
+
+func TestEndomorphismLaws(t *testing.T) {
+	exceptional := 0
+	for _, S := range smallOrderPoints {
+		P := _AffineToR1(S)
+		if !endoExceptional(P) {
+			continue
+		}
+
+		exceptional += 1
+		x2, y2 := fp2sqr(S.X), fp2sqr(S.Y)
+		if x2 != fp2neg(y2) || r1Equal(dbl(dbl(P)), O1) || !r1Equal(dbl(dbl(dbl(P))), O1) {
+			t.Fatalf("failed exceptional point test")
+		}
+	}
+	if exceptional != 4 {
+		t.Fatalf("failed exceptional point count test")
+	}
+
+	quickCheck(t, "tau", func(P quickAnyPoint) bool {
+		return r1Equal(tauDual(tau(_R1toR4(P.P))), dbl(dbl(P.P)))
+	})
+
+	quickCheck(t, "phi eigenvalue", func(P quickPoint) bool {
+		return r1Equal(phi(P.P), mulWindowed(lambdaPhi, P.P, nil))
+	})
+
+	quickCheck(t, "psi eigenvalue", func(P quickPoint) bool {
+		return r1Equal(psi(P.P), mulWindowed(lambdaPsi, P.P, nil))
+	})
+
+	for name, endo := range map[string]func(r1) r1{"phi": phi, "psi": psi} {
+		quickCheck(t, name+" homomorphism", func(P, Q quickAnyPoint) bool {
+			PQ := add(P.P, _R1toR2(Q.P))
+			if endoExceptional(P.P) || endoExceptional(Q.P) || endoExceptional(PQ) {
+				return true
+			}
+			return r1Equal(endo(PQ), add(endo(P.P), _R1toR2(endo(Q.P))))
+		})
+
+		quickCheck(t, name+" cofactor", func(P quickAnyPoint) bool {
+			return r1Equal(mulCofactor(endo(P.P)), endo(mulCofactor(P.P))) || endoExceptional(P.P)
+		})
+	}
+}
+
+func TestScalarLaws(t *testing.T) {
+	mul := func(m scalar, P r1) r1 {
+		return mulEndo(m, P, nil)
+	}
+
+	quickCheck(t, "distributivity over points", func(a quickScalar, P, Q quickPoint) bool {
+		return r1Equal(mul(a.m, add(P.P, _R1toR2(Q.P))), add(mul(a.m, P.P), _R1toR2(mul(a.m, Q.P))))
+	})
+
+	quickCheck(t, "distributivity over scalars", func(a, b quickScalar, P quickPoint) bool {
+		return r1Equal(mul(saddmodN(a.m, b.m), P.P), add(mul(a.m, P.P), _R1toR2(mul(b.m, P.P))))
+	})
+
+	quickCheck(t, "compatibility", func(a, b quickScalar, P quickPoint) bool {
+		return r1Equal(mul(smulmodN(a.m, b.m), P.P), mul(a.m, mul(b.m, P.P)))
+	})
+
+	quickCheck(t, "negation", func(a quickScalar, P quickPoint) bool {
+		return r1Equal(mul(a.m, r1Neg(P.P)), r1Neg(mul(a.m, P.P)))
+	})
+
+	quickCheck(t, "mulWindowed", func(a quickScalar, P quickPoint) bool {
+		return r1Equal(mulWindowed(a.m, P.P, nil), mul(a.m, P.P))
+	})
+}
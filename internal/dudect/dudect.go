@@ -0,0 +1,150 @@
+// Package dudect tests functions for timing leaks, following "Dude, is my
+// code constant time?" by Reparaz, Balasch and Verbauwhede.  A function is
+// timed on inputs from two classes, a fixed input and random inputs, and
+// Welch's t-test is applied to the two distributions of timings.  A large
+// |t| is evidence that the running time depends on the input.
+package dudect
+
+import (
+	"crypto/rand"
+	"math"
+	"sort"
+	"time"
+)
+
+// A |t| above this threshold is reported as a leak
+const Threshold = 4.5
+
+// Timings above each of these percentiles are cropped, in addition to
+// testing the uncropped timings, since a leak may be hidden by noise in
+// the upper tail of the distribution
+const numPercentiles = 100
+
+// Measurements are taken in batches of this size.  The first batch is
+// used only to warm up and to compute the percentiles.
+const batchSize = 1000
+
+// A Target is a function whose running time should not depend on its
+// input
+type Target struct {
+	Name string
+
+	// Length of the input, in bytes
+	Size int
+
+	// Input for the fixed class, padded with zeros to Size
+	Fixed []byte
+
+	// Set if the function is documented to be variable-time, so that a
+	// leak is expected
+	VarTime bool
+
+	Run func(in []byte)
+}
+
+type Result struct {
+	Name         string
+	VarTime      bool
+	Measurements int
+	MaxT         float64
+}
+
+func (r Result) Leaks() bool {
+	return r.MaxT > Threshold
+}
+
+// Online mean and variance for each class (Welford's algorithm)
+type welch struct {
+	n, mean, m2 [2]float64
+}
+
+func (w *welch) add(class int, x float64) {
+	w.n[class] += 1
+	delta := x - w.mean[class]
+	w.mean[class] += delta / w.n[class]
+	w.m2[class] += delta * (x - w.mean[class])
+}
+
+func (w *welch) t() float64 {
+	if w.n[0] < 2 || w.n[1] < 2 {
+		return 0
+	}
+
+	v0 := w.m2[0] / (w.n[0] - 1)
+	v1 := w.m2[1] / (w.n[1] - 1)
+	den := math.Sqrt(v0/w.n[0] + v1/w.n[1])
+	if den == 0 {
+		return 0
+	}
+	return (w.mean[0] - w.mean[1]) / den
+}
+
+func percentiles(timings []float64) []float64 {
+	sorted := append([]float64{}, timings...)
+	sort.Float64s(sorted)
+
+	p := make([]float64, numPercentiles)
+	for i := range p {
+		q := 1 - math.Pow(0.5, 10*float64(i+1)/numPercentiles)
+		p[i] = sorted[int(q*float64(len(sorted)-1))]
+	}
+	return p
+}
+
+// Measure takes n measurements of the target, and reports the largest
+// |t| among the uncropped and cropped tests
+func Measure(target Target, n int) Result {
+	fixed := make([]byte, target.Size)
+	copy(fixed, target.Fixed)
+
+	inputs := make([][]byte, batchSize)
+	for i := range inputs {
+		inputs[i] = make([]byte, target.Size)
+	}
+	classes := make([]byte, batchSize)
+	timings := make([]float64, batchSize)
+
+	tests := make([]welch, numPercentiles+1)
+	var cutoffs []float64
+	measured := 0
+	for measured < n {
+		// Inputs are prepared outside the timed region
+		rand.Read(classes)
+		for i := range inputs {
+			classes[i] &= 1
+			if classes[i] == 0 {
+				copy(inputs[i], fixed)
+			} else {
+				rand.Read(inputs[i])
+			}
+		}
+
+		for i, in := range inputs {
+			start := time.Now()
+			target.Run(in)
+			timings[i] = float64(time.Since(start))
+		}
+
+		if cutoffs == nil {
+			cutoffs = percentiles(timings)
+			continue
+		}
+
+		for i, x := range timings {
+			class := int(classes[i])
+			tests[0].add(class, x)
+			for j, cutoff := range cutoffs {
+				if x < cutoff {
+					tests[j+1].add(class, x)
+				}
+			}
+		}
+		measured += batchSize
+	}
+
+	r := Result{Name: target.Name, VarTime: target.VarTime, Measurements: measured}
+	for i := range tests {
+		r.MaxT = math.Max(r.MaxT, math.Abs(tests[i].t()))
+	}
+	return r
+}
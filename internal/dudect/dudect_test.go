@@ -0,0 +1,51 @@
+package dudect
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWelch(t *testing.T) {
+	// Class 0 has mean 2 and sample variance 4/5, and class 1 has mean 5
+	// and sample variance 8/3
+	var w welch
+	for _, x := range []float64{1, 2, 3, 1, 2, 3} {
+		w.add(0, x)
+	}
+	for _, x := range []float64{3, 5, 7, 5} {
+		w.add(1, x)
+	}
+
+	expected := -3 / math.Sqrt(0.8/6+(8.0/3)/4)
+	if math.Abs(w.t()-expected) > 1e-9 {
+		t.Fatalf("failed Welch t-test test: %v != %v", w.t(), expected)
+	}
+
+	var z welch
+	z.add(0, 1)
+	if z.t() != 0 {
+		t.Fatalf("failed Welch t-test empty class test")
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	TEST_LOOPS := 20000
+
+	// The running time is proportional to the first byte of the input, so
+	// the fixed class (zero) is much faster than the random class
+	sink := 0
+	leaky := Target{
+		Name: "leaky",
+		Size: 1,
+		Run: func(in []byte) {
+			for i := 0; i < 100*int(in[0]); i += 1 {
+				sink += i
+			}
+		},
+	}
+
+	r := Measure(leaky, TEST_LOOPS)
+	if !r.Leaks() || r.Measurements < TEST_LOOPS {
+		t.Fatalf("failed leak detection test: %+v", r)
+	}
+}
@@ -0,0 +1,285 @@
+// Package params checks the curve parameters hard-coded in curve4q, and the
+// constants derived from them, against independent computations with
+// math/big and the reference implementation in internal/ref.  It is used
+// by cmd/fourq-params and by the tests of curve4q, which pass in the
+// constants, since they are not exported.
+package params
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bifurcation/curve4q/internal/ref"
+)
+
+// Constants holds the parameters to check.  Integers are 64-bit words,
+// least significant first, elements of GF(p^2) are in the encoding of
+// curve4q, and the endomorphisms act on point encodings.
+type Constants struct {
+	P                                  [2]uint64
+	N, CofactorInv                     [4]uint64
+	Cofactor                           uint64
+	D, Dinv, Gx, Gy                    [32]byte
+	LambdaPhi, LambdaPsi, LambdaPhiPsi [4]uint64
+
+	// The lattice used by decompose: the absolute values of b1, ..., b4,
+	// the offset c, and L1, ..., L4
+	B [4][4]uint64
+	C [4]uint64
+	L [4][4]uint64
+
+	// Phi, Psi, and tauDual after tau, which fail for invalid points
+	Phi, Psi, TauDualTau func(P [32]byte) ([32]byte, error)
+}
+
+// A Check is the result of one of the checks in Audit
+type Check struct {
+	Name string
+	Err  error // nil if the check passed
+}
+
+func wordsToBig(x []uint64) *big.Int {
+	z := new(big.Int)
+	for i := len(x) - 1; i >= 0; i -= 1 {
+		z.Lsh(z, 64)
+		z.Add(z, new(big.Int).SetUint64(x[i]))
+	}
+	return z
+}
+
+func decodeFp2(buf [32]byte) ref.Fp2 {
+	return ref.Fp2{A: ref.FromLE(buf[0:16]), B: ref.FromLE(buf[16:32])}
+}
+
+// DecomposeBasis returns the signed lattice basis used by decompose, with
+// the absolute values b, such that
+//
+//	decompose(m) = (m, 0, 0, 0) + c + sum_i t_i * v_i
+func DecomposeBasis(b [4][4]uint64) [4][4]*big.Int {
+	signs := [4][4]int64{
+		{-1, +1, -1, +1},
+		{-1, +1, -1, -1},
+		{-1, -1, +1, -1},
+		{-1, -1, +1, +1},
+	}
+
+	var v [4][4]*big.Int
+	for i := range b {
+		for j := range b[i] {
+			v[i][j] = new(big.Int).SetUint64(b[i][j])
+			v[i][j].Mul(v[i][j], big.NewInt(signs[i][j]))
+		}
+	}
+	return v
+}
+
+// Reports an error unless a0 + a1*lambda1 + a2*lambda2 + a3*lambda3 = 0
+// mod N, where lambda0 = 1
+func checkKernel(a [4]*big.Int, lambda [4]*big.Int, N *big.Int) error {
+	sum := new(big.Int)
+	for j := range a {
+		sum.Add(sum, new(big.Int).Mul(a[j], lambda[j]))
+	}
+	if sum.Mod(sum, N).Sign() != 0 {
+		return errors.New("not in the kernel of the decomposition")
+	}
+	return nil
+}
+
+// Solves for the rational alpha with (1, 0, 0, 0) = -sum_i alpha_i v_i.
+// decompose approximates t_i = floor(m * alpha_i) by
+// floor(m * L_i / 2^256), so L_i should be alpha_i * 2^256, rounded.
+func checkRounding(v [4][4]*big.Int, L [4]*big.Int) error {
+	// Rows of the augmented matrix [v^T | -e_0]
+	var A [4][5]*big.Rat
+	for j := 0; j < 4; j += 1 {
+		for i := 0; i < 4; i += 1 {
+			A[j][i] = new(big.Rat).SetInt(v[i][j])
+		}
+		A[j][4] = new(big.Rat)
+	}
+	A[0][4].SetInt64(-1)
+
+	for col := 0; col < 4; col += 1 {
+		piv := col
+		for A[piv][col].Sign() == 0 {
+			piv += 1
+		}
+		A[col], A[piv] = A[piv], A[col]
+
+		for r := 0; r < 4; r += 1 {
+			if r == col || A[r][col].Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Quo(A[r][col], A[col][col])
+			for k := col; k < 5; k += 1 {
+				A[r][k] = new(big.Rat).Sub(A[r][k], new(big.Rat).Mul(f, A[col][k]))
+			}
+		}
+	}
+
+	two256 := new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 256))
+	half := big.NewRat(1, 2)
+	for i := 0; i < 4; i += 1 {
+		alpha := new(big.Rat).Quo(A[i][4], A[i][i])
+		x := new(big.Rat).Add(new(big.Rat).Mul(alpha, two256), half)
+		rounded := new(big.Int).Quo(x.Num(), x.Denom())
+		if rounded.Cmp(L[i]) != 0 {
+			return fmt.Errorf("L%d does not match the lattice basis", i+1)
+		}
+	}
+	return nil
+}
+
+// Audit checks the hard-coded curve parameters and the constants derived
+// from them
+func Audit(k Constants) []Check {
+	p := wordsToBig(k.P[:])
+	N := wordsToBig(k.N[:])
+	cofactor := new(big.Int).SetUint64(k.Cofactor)
+	lphi, lpsi := wordsToBig(k.LambdaPhi[:]), wordsToBig(k.LambdaPsi[:])
+	lambda := [4]*big.Int{big.NewInt(1), lphi, lpsi, wordsToBig(k.LambdaPhiPsi[:])}
+	G := ref.Point{X: decodeFp2(k.Gx), Y: decodeFp2(k.Gy)}
+	v := DecomposeBasis(k.B)
+
+	// Checks that f(P) = [lambda]P for G and another point of order N
+	checkEigenvalue := func(f func([32]byte) ([32]byte, error), lambda *big.Int) error {
+		for _, m := range []int64{1, 0x1234567} {
+			P := G.ScalarMult(big.NewInt(m))
+			Q, err := f(ref.Encode(P))
+			if err != nil || Q != ref.Encode(P.ScalarMult(lambda)) {
+				return errors.New("endomorphism does not match its eigenvalue")
+			}
+		}
+		return nil
+	}
+
+	checks := []struct {
+		name  string
+		check func() error
+	}{
+		{"p = 2^127 - 1 is prime", func() error {
+			if p.Cmp(ref.P) != 0 || !ref.P.ProbablyPrime(32) {
+				return errors.New("p is not prime")
+			}
+			return nil
+		}},
+		{"N is prime", func() error {
+			if N.Cmp(ref.N) != 0 || !N.ProbablyPrime(32) {
+				return errors.New("N is not prime")
+			}
+			return nil
+		}},
+		{"d matches the published value", func() error {
+			if !decodeFp2(k.D).Equal(ref.D) {
+				return errors.New("d does not match")
+			}
+			if !decodeFp2(k.Dinv).Mul(ref.D).Equal(ref.Int(1)) {
+				return errors.New("dinv is not the inverse of d")
+			}
+			return nil
+		}},
+		{"d is not a square, so the addition law is complete", func() error {
+			if ref.D.IsSquare() {
+				return errors.New("d is a square")
+			}
+			return nil
+		}},
+		{"G is on the curve and has order N", func() error {
+			if !G.Equal(ref.G) || !G.OnCurve() || G.Equal(ref.Neutral) {
+				return errors.New("G is not a valid point")
+			}
+			if !G.ScalarMult(N).Equal(ref.Neutral) {
+				return errors.New("[N]G is not the neutral point")
+			}
+			return nil
+		}},
+		{"the curve has order 392*N", func() error {
+			// If P has order a multiple of N, then since N > 4p, there
+			// is at most one multiple of its order in the Hasse interval
+			// [p^2 + 1 - 2p, p^2 + 1 + 2p].  If 392*N is in the interval
+			// and kills P, then it is the order of the curve.
+			order := new(big.Int).Mul(cofactor, N)
+			center := new(big.Int).Add(new(big.Int).Mul(ref.P, ref.P), big.NewInt(1))
+			dist := new(big.Int).Sub(order, center)
+			if dist.Abs(dist).Cmp(new(big.Int).Lsh(ref.P, 1)) > 0 {
+				return errors.New("392*N is outside the Hasse interval")
+			}
+			if N.Cmp(new(big.Int).Lsh(ref.P, 2)) <= 0 {
+				return errors.New("N is too small for this argument")
+			}
+
+			var P ref.Point
+			for y := int64(2); ; y += 1 {
+				var buf [32]byte
+				copy(buf[0:16], ref.ToLE(big.NewInt(y), 16))
+				buf[16] = 1
+				var ok bool
+				if P, ok = ref.Decode(buf[:]); ok {
+					break
+				}
+			}
+
+			if !P.OnCurve() || P.ScalarMult(cofactor).Equal(ref.Neutral) || !P.ScalarMult(order).Equal(ref.Neutral) {
+				return errors.New("[392*N]P is not the neutral point")
+			}
+			return nil
+		}},
+		{"tauDual(tau(P)) = [4]P", func() error {
+			Q, err := k.TauDualTau(ref.Encode(G))
+			if err != nil || Q != ref.Encode(G.ScalarMult(big.NewInt(4))) {
+				return errors.New("ctau and ctaudual are inconsistent")
+			}
+			return nil
+		}},
+		{"phi(P) = [lambdaPhi]P", func() error {
+			return checkEigenvalue(k.Phi, lphi)
+		}},
+		{"psi(P) = [lambdaPsi]P", func() error {
+			return checkEigenvalue(k.Psi, lpsi)
+		}},
+		{"lambdaPhiPsi = lambdaPhi * lambdaPsi", func() error {
+			x := new(big.Int).Mul(lphi, lpsi)
+			if x.Mod(x, N).Cmp(lambda[3]) != 0 {
+				return errors.New("lambdaPhiPsi does not match")
+			}
+			return nil
+		}},
+		{"b1, ..., b4 are in the kernel of the decomposition", func() error {
+			for i := range v {
+				if err := checkKernel(v[i], lambda, N); err != nil {
+					return fmt.Errorf("b%d: %v", i+1, err)
+				}
+			}
+			return nil
+		}},
+		{"c is in the kernel of the decomposition", func() error {
+			var c [4]*big.Int
+			for j := range k.C {
+				c[j] = new(big.Int).SetUint64(k.C[j])
+			}
+			return checkKernel(c, lambda, N)
+		}},
+		{"L1, ..., L4 are the rounded lattice coordinates", func() error {
+			var L [4]*big.Int
+			for i := range k.L {
+				L[i] = wordsToBig(k.L[i][:])
+			}
+			return checkRounding(v, L)
+		}},
+		{"cofactorInv = 392^-1 mod N", func() error {
+			x := new(big.Int).Mul(wordsToBig(k.CofactorInv[:]), cofactor)
+			if x.Mod(x, N).Cmp(big.NewInt(1)) != 0 {
+				return errors.New("cofactorInv is not the inverse of 392")
+			}
+			return nil
+		}},
+	}
+
+	results := make([]Check, len(checks))
+	for i, ch := range checks {
+		results[i] = Check{Name: ch.name, Err: ch.check()}
+	}
+	return results
+}
@@ -0,0 +1,260 @@
+// Package ref is a deliberately simple implementation of FourQ, using
+// math/big for arithmetic in GF(p^2), affine coordinates, and
+// double-and-add scalar multiplication.  It is used to test the
+// optimized implementation, and is neither fast nor constant-time.
+package ref
+
+import (
+	"math/big"
+)
+
+var (
+	// p = 2^127 - 1
+	P = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+
+	// Order of the subgroup generated by G
+	N, _ = new(big.Int).SetString("0029cbc14e5e0a72f05397829cbc14e5dfbd004dfe0f79992fb2540ec7768ce7", 16)
+
+	// Cofactor, the order of the curve divided by N
+	Cofactor = big.NewInt(392)
+
+	// Curve parameter d, as published in the FourQ paper
+	D = Fp2{
+		A: fromDecimal("4205857648805777768770"),
+		B: fromDecimal("125317048443780598345676279555970305165"),
+	}
+
+	// Base point
+	G = Point{
+		X: Fp2{A: fromHex("1a3472237c2fb305286592ad7b3833aa"), B: fromHex("1e1f553f2878aa9c96869fb360ac77f6")},
+		Y: Fp2{A: fromHex("0e3fee9ba120785ab924a2462bcbb287"), B: fromHex("6e1c4af8630e024249a7c344844c8b5c")},
+	}
+
+	Neutral = Point{X: Int(0), Y: Int(1)}
+)
+
+func fromDecimal(s string) *big.Int {
+	x, _ := new(big.Int).SetString(s, 10)
+	return x
+}
+
+func fromHex(s string) *big.Int {
+	x, _ := new(big.Int).SetString(s, 16)
+	return x
+}
+
+/********** GF(p^2) = GF(p)[i] / (i^2 + 1) **********/
+
+// An Fp2 is A + B*i, with A and B reduced modulo p
+type Fp2 struct{ A, B *big.Int }
+
+func mod(x *big.Int) *big.Int {
+	return x.Mod(x, P)
+}
+
+func Int(a int64) Fp2 {
+	return Fp2{mod(big.NewInt(a)), big.NewInt(0)}
+}
+
+func (x Fp2) Equal(y Fp2) bool {
+	return x.A.Cmp(y.A) == 0 && x.B.Cmp(y.B) == 0
+}
+
+func (x Fp2) IsZero() bool {
+	return x.A.Sign() == 0 && x.B.Sign() == 0
+}
+
+func (x Fp2) Add(y Fp2) Fp2 {
+	return Fp2{mod(new(big.Int).Add(x.A, y.A)), mod(new(big.Int).Add(x.B, y.B))}
+}
+
+func (x Fp2) Neg() Fp2 {
+	return Fp2{mod(new(big.Int).Neg(x.A)), mod(new(big.Int).Neg(x.B))}
+}
+
+func (x Fp2) Sub(y Fp2) Fp2 {
+	return x.Add(y.Neg())
+}
+
+func (x Fp2) Mul(y Fp2) Fp2 {
+	a := new(big.Int).Mul(x.A, y.A)
+	a.Sub(a, new(big.Int).Mul(x.B, y.B))
+	b := new(big.Int).Mul(x.A, y.B)
+	b.Add(b, new(big.Int).Mul(x.B, y.A))
+	return Fp2{mod(a), mod(b)}
+}
+
+// A^2 + B^2, which is in GF(p)
+func (x Fp2) Norm() *big.Int {
+	n := new(big.Int).Mul(x.A, x.A)
+	return mod(n.Add(n, new(big.Int).Mul(x.B, x.B)))
+}
+
+// Inv returns 1/x, or zero if x is zero
+func (x Fp2) Inv() Fp2 {
+	n := new(big.Int).ModInverse(x.Norm(), P)
+	if n == nil {
+		return Int(0)
+	}
+	return Fp2{mod(new(big.Int).Mul(x.A, n)), mod(new(big.Int).Neg(new(big.Int).Mul(x.B, n)))}
+}
+
+// An element of GF(p^2) is a square if and only if its norm is a square
+// in GF(p)
+func (x Fp2) IsSquare() bool {
+	return big.Jacobi(x.Norm(), P) >= 0
+}
+
+// Square root in GF(p), for p = 3 mod 4
+func sqrtFp(a *big.Int) (*big.Int, bool) {
+	e := new(big.Int).Rsh(new(big.Int).Add(P, big.NewInt(1)), 2)
+	r := new(big.Int).Exp(a, e, P)
+	return r, mod(new(big.Int).Mul(r, r)).Cmp(mod(new(big.Int).Set(a))) == 0
+}
+
+// Sqrt returns a square root of x, if there is one.  With x = a + bi
+// and r = sqrt(a^2 + b^2), a root is s + (b / 2s)i for s^2 = (a + r)/2
+// or (a - r)/2.
+func (x Fp2) Sqrt() (Fp2, bool) {
+	if x.B.Sign() == 0 {
+		if s, ok := sqrtFp(x.A); ok {
+			return Fp2{s, big.NewInt(0)}, true
+		}
+		s, _ := sqrtFp(mod(new(big.Int).Neg(x.A)))
+		return Fp2{big.NewInt(0), s}, true
+	}
+
+	r, ok := sqrtFp(x.Norm())
+	if !ok {
+		return Fp2{}, false
+	}
+
+	half := new(big.Int).ModInverse(big.NewInt(2), P)
+	for _, sign := range []int64{1, -1} {
+		delta := new(big.Int).Add(x.A, new(big.Int).Mul(big.NewInt(sign), r))
+		delta = mod(delta.Mul(delta, half))
+		if s, ok := sqrtFp(delta); ok && s.Sign() != 0 {
+			t := new(big.Int).Mul(x.B, new(big.Int).ModInverse(new(big.Int).Lsh(s, 1), P))
+			return Fp2{s, mod(t)}, true
+		}
+	}
+	return Fp2{}, false
+}
+
+/********** Points on -x^2 + y^2 = 1 + d x^2 y^2 **********/
+
+type Point struct{ X, Y Fp2 }
+
+func (P Point) Equal(Q Point) bool {
+	return P.X.Equal(Q.X) && P.Y.Equal(Q.Y)
+}
+
+func (P Point) OnCurve() bool {
+	x2 := P.X.Mul(P.X)
+	y2 := P.Y.Mul(P.Y)
+	return y2.Sub(x2).Equal(Int(1).Add(D.Mul(x2).Mul(y2)))
+}
+
+func (P Point) Neg() Point {
+	return Point{P.X.Neg(), P.Y}
+}
+
+// Add uses the affine addition law, which is complete because d is not
+// a square
+func (P Point) Add(Q Point) Point {
+	t := D.Mul(P.X).Mul(Q.X).Mul(P.Y).Mul(Q.Y)
+	x := P.X.Mul(Q.Y).Add(P.Y.Mul(Q.X)).Mul(Int(1).Add(t).Inv())
+	y := P.Y.Mul(Q.Y).Add(P.X.Mul(Q.X)).Mul(Int(1).Sub(t).Inv())
+	return Point{x, y}
+}
+
+func (P Point) Double() Point {
+	return P.Add(P)
+}
+
+// ScalarMult computes [k]P for k >= 0, by double-and-add
+func (P Point) ScalarMult(k *big.Int) Point {
+	Q := Neutral
+	for i := k.BitLen() - 1; i >= 0; i -= 1 {
+		Q = Q.Double()
+		if k.Bit(i) == 1 {
+			Q = Q.Add(P)
+		}
+	}
+	return Q
+}
+
+/********** Encoding **********/
+
+// Points are encoded as the 16-byte little-endian encodings of the real
+// and imaginary parts of y, with the top bit of the last byte set to the
+// "sign" of x: bit 126 of its real part, or of its imaginary part if
+// the real part is zero.
+
+func sign(x Fp2) uint {
+	if x.A.Sign() != 0 {
+		return x.A.Bit(126)
+	}
+	return x.B.Bit(126)
+}
+
+// ToLE returns the n-byte little-endian encoding of x, which must be
+// non-negative and less than 2^(8n)
+func ToLE(x *big.Int, n int) []byte {
+	b := x.FillBytes(make([]byte, n))
+	for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return b
+}
+
+// FromLE decodes a little-endian integer of any length
+func FromLE(buf []byte) *big.Int {
+	b := make([]byte, len(buf))
+	for i := range buf {
+		b[len(buf)-1-i] = buf[i]
+	}
+	return new(big.Int).SetBytes(b)
+}
+
+func Encode(P Point) (out [32]byte) {
+	copy(out[0:16], ToLE(P.Y.A, 16))
+	copy(out[16:32], ToLE(P.Y.B, 16))
+	out[31] |= byte(sign(P.X) << 7)
+	return
+}
+
+// Decode recovers x from x^2 = (y^2 - 1) / (d y^2 + 1).  Encodings that
+// are not canonical, with a part of y not less than p or the sign bit
+// set for x = 0, are rejected.
+func Decode(buf []byte) (Point, bool) {
+	if len(buf) != 32 || buf[15]&0x80 != 0 {
+		return Point{}, false
+	}
+
+	s := uint(buf[31] >> 7)
+	b := make([]byte, 32)
+	copy(b, buf)
+	b[31] &= 0x7f
+
+	y := Fp2{FromLE(b[0:16]), FromLE(b[16:32])}
+	if y.A.Cmp(P) >= 0 || y.B.Cmp(P) >= 0 {
+		return Point{}, false
+	}
+
+	y2 := y.Mul(y)
+	x2 := y2.Sub(Int(1)).Mul(D.Mul(y2).Add(Int(1)).Inv())
+	x, ok := x2.Sqrt()
+	if !ok {
+		return Point{}, false
+	}
+	if sign(x) != s {
+		x = x.Neg()
+	}
+	if sign(x) != s {
+		return Point{}, false
+	}
+
+	P := Point{x, y}
+	return P, P.OnCurve()
+}
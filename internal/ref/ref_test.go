@@ -0,0 +1,61 @@
+package ref
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestCurve(t *testing.T) {
+	if !G.OnCurve() || G.Equal(Neutral) || !G.ScalarMult(N).Equal(Neutral) {
+		t.Fatalf("failed base point test")
+	}
+
+	order := new(big.Int).Mul(Cofactor, N)
+	P := G.Add(Point{X: Int(0), Y: Int(-1)})
+	if !P.OnCurve() || !P.ScalarMult(order).Equal(Neutral) || P.ScalarMult(N).Equal(Neutral) {
+		t.Fatalf("failed torsion point test")
+	}
+
+	if !G.Add(G.Neg()).Equal(Neutral) || !G.Double().Equal(G.ScalarMult(big.NewInt(2))) {
+		t.Fatalf("failed addition test")
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	TEST_LOOPS := 100
+
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		x := Fp2{new(big.Int).Rand(r, P), new(big.Int).Rand(r, P)}
+		if i%4 == 0 {
+			x.B.SetInt64(0)
+		}
+
+		x2 := x.Mul(x)
+		s, ok := x2.Sqrt()
+		if !ok || !s.Mul(s).Equal(x2) {
+			t.Fatalf("failed Sqrt test")
+		}
+
+		if _, ok := x.Sqrt(); ok != x.IsSquare() {
+			t.Fatalf("failed IsSquare test")
+		}
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	TEST_LOOPS := 20
+
+	P := G
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		P = P.Double().Add(G)
+		for _, Q := range []Point{P, P.Neg()} {
+			enc := Encode(Q)
+			dec, ok := Decode(enc[:])
+			if !ok || !dec.Equal(Q) {
+				t.Fatalf("failed encode/decode test")
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package curve4q
+
+import (
+	"bytes"
+	"crypto/hkdf"
+	"crypto/sha512"
+)
+
+// NewKeyFromSeed derives a private key from a 32-byte seed with the key
+// expansion of SchnorrQ: the secret scalar s is the first half of
+// SHA-512(seed), as a little-endian integer reduced modulo N.  Since
+// ScalarBaseMult multiplies by the cofactor, the key holds s / 392 mod N,
+// so that its public key is [s]G, the SchnorrQ public key for the seed.
+func NewKeyFromSeed(seed *[32]byte) *PrivateKey {
+	h := sha512.Sum512(seed[:])
+	s := sreduce(h[:32])
+	return &PrivateKey{m: smulmodN(s, cofactorInv)}
+}
+
+// DeriveSharedKey computes the shared secret of priv and the public key
+// peer, and derives a key of the given length from it with HKDF-SHA-512:
+//
+//	HKDF(IKM = shared || pk1 || pk2, salt = "", info)
+//
+// where pk1 and pk2 are the public keys of the two parties, in
+// lexicographic order, so that both derive the same key.  It returns
+// ErrInvalidPoint for an invalid peer key, and ErrNeutralPoint if the
+// shared secret is the neutral point, as it is for a peer key of small
+// order, and ErrKeyLength unless 0 <= length <= 255*64.
+func DeriveSharedKey(priv *PrivateKey, peer *[32]byte, info []byte, length int) ([]byte, error) {
+	if length < 0 || length > 255*sha512.Size {
+		return nil, ErrKeyLength
+	}
+
+	P, err := decode(peer[:])
+	if err != nil {
+		return nil, err
+	}
+	Q, err := priv.dh(P, nil)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := priv.publicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	pk1, pk2 := pub[:], peer[:]
+	if bytes.Compare(pk1, pk2) > 0 {
+		pk1, pk2 = pk2, pk1
+	}
+
+	ikm := make([]byte, 0, 96)
+	ikm = append(ikm, encode(Q)...)
+	ikm = append(ikm, pk1...)
+	ikm = append(ikm, pk2...)
+	return hkdf.Key(sha512.New, ikm, nil, string(info), length)
+}
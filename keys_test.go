@@ -0,0 +1,95 @@
+package curve4q
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"testing"
+
+	"github.com/bifurcation/curve4q/internal/ref"
+)
+
+func TestNewKeyFromSeed(t *testing.T) {
+	TEST_LOOPS := 10
+
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var seed [32]byte
+		rand.Read(seed[:])
+
+		// The SchnorrQ public key is [s]G, without the cofactor
+		h := sha512.Sum512(seed[:])
+		s := ref.FromLE(h[:32])
+		expected := ref.Encode(ref.G.ScalarMult(s.Mod(s, ref.N)))
+
+		var pub [32]byte
+		if err := NewKeyFromSeed(&seed).ScalarBaseMult(&pub); err != nil || pub != expected {
+			t.Fatalf("failed NewKeyFromSeed test: %v", err)
+		}
+	}
+}
+
+func TestDeriveSharedKey(t *testing.T) {
+	TEST_LOOPS := 10
+
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var seedA, seedB, pubA, pubB [32]byte
+		rand.Read(seedA[:])
+		rand.Read(seedB[:])
+		a, b := NewKeyFromSeed(&seedA), NewKeyFromSeed(&seedB)
+		a.ScalarBaseMult(&pubA)
+		b.ScalarBaseMult(&pubB)
+
+		kA, errA := DeriveSharedKey(a, &pubB, []byte("test"), 48)
+		kB, errB := DeriveSharedKey(b, &pubA, []byte("test"), 48)
+		if errA != nil || errB != nil || len(kA) != 48 || !bytes.Equal(kA, kB) {
+			t.Fatalf("failed DeriveSharedKey agreement test: %v, %v", errA, errB)
+		}
+
+		k, err := DeriveSharedKey(a, &pubB, []byte("other"), 48)
+		if err != nil || bytes.Equal(k, kA) {
+			t.Fatalf("failed DeriveSharedKey info test: %v", err)
+		}
+
+		b.Randomize, b.DetectFaults = true, true
+		k, err = DeriveSharedKey(b, &pubA, []byte("test"), 48)
+		if err != nil || !bytes.Equal(k, kA) {
+			t.Fatalf("failed DeriveSharedKey countermeasures test: %v", err)
+		}
+	}
+
+	var seed [32]byte
+	priv := NewKeyFromSeed(&seed)
+	for _, S := range smallOrderPoints {
+		var T [32]byte
+		copy(T[:], encode(S))
+		if _, err := DeriveSharedKey(priv, &T, nil, 32); err != ErrNeutralPoint {
+			t.Fatalf("failed DeriveSharedKey small order test: %v", err)
+		}
+	}
+
+	var bad, G [32]byte
+	bad[15] = 0x80
+	if _, err := DeriveSharedKey(priv, &bad, nil, 32); err != ErrInvalidPoint {
+		t.Fatalf("failed DeriveSharedKey invalid point test: %v", err)
+	}
+
+	copy(G[:], encode(affine{Gx, Gy}))
+	if _, err := DeriveSharedKey(&PrivateKey{}, &G, nil, 32); err != ErrNeutralPoint {
+		t.Fatalf("failed DeriveSharedKey zero key test: %v", err)
+	}
+	if _, err := DeriveSharedKey(priv, &G, nil, 255*64+1); err != ErrKeyLength {
+		t.Fatalf("failed DeriveSharedKey length test")
+	}
+	if _, err := DeriveSharedKey(priv, &G, nil, -1); err != ErrKeyLength {
+		t.Fatalf("failed DeriveSharedKey negative length test")
+	}
+	if k, err := DeriveSharedKey(priv, &G, nil, 0); err != nil || len(k) != 0 {
+		t.Fatalf("failed DeriveSharedKey zero length test: %v", err)
+	}
+
+	var pub [32]byte
+	priv.ScalarBaseMult(&pub)
+	if cached := priv.pub.Load(); cached == nil || *cached != pub {
+		t.Fatalf("failed DeriveSharedKey public key cache test")
+	}
+}
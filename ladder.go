@@ -0,0 +1,98 @@
+package curve4q
+
+/********** x-only Montgomery ladder **********/
+
+// Number of bits in 392 times a scalar from decodeScalar, which is less
+// than N
+const ladderBits = 255
+
+// The ladder is for a simpler Diffie-Hellman variant on the Montgomery
+// model (see models.go), which uses only u-coordinates, in the style of
+// X25519.  Public keys are the 32-byte encodings of u-coordinates, and
+// the shared secret is the u-coordinate of [392*m]P.
+//
+// Returns (X : Z) such that X/Z is the u-coordinate of [k]P, where u is
+// the u-coordinate of P and k < 2^n.  Z is zero if [k]P is the neutral
+// point.  The constant ladderA24 = (A - 2)/4, and basePointU, the
+// u-coordinate of the base point, are generated by modelConstants and
+// are in tables.go.
+func ladder(k scalar, u fp2elt, n int) (X2, Z2 fp2elt) {
+	X2, Z2 = fp2One, fp2Zero
+	X3, Z3 := u, fp2One
+	swap := uint64(0)
+
+	for t := n - 1; t >= 0; t -= 1 {
+		kt := (k[t/64] >> uint(t%64)) & 1
+		swap ^= kt
+		X2, X3 = fp2select(swap, X3, X2), fp2select(swap, X2, X3)
+		Z2, Z3 = fp2select(swap, Z3, Z2), fp2select(swap, Z2, Z3)
+		swap = kt
+
+		A := fp2add(X2, Z2)
+		AA := fp2sqr(A)
+		B := fp2sub(X2, Z2)
+		BB := fp2sqr(B)
+		E := fp2sub(AA, BB)
+		C := fp2add(X3, Z3)
+		D := fp2sub(X3, Z3)
+		DA := fp2mul(D, A)
+		CB := fp2mul(C, B)
+
+		X3 = fp2sqr(fp2add(DA, CB))
+		Z3 = fp2mul(u, fp2sqr(fp2sub(DA, CB)))
+		X2 = fp2mul(AA, BB)
+		Z2 = fp2mul(E, fp2add(AA, fp2mul(ladderA24, E)))
+	}
+
+	X2 = fp2select(swap, X3, X2)
+	Z2 = fp2select(swap, Z3, Z2)
+	return
+}
+
+// Computes the u-coordinate of [392*m]P, or returns ErrNeutralPoint
+func dhLadder(m scalar, u fp2elt) (fp2elt, error) {
+	_, k := smulw(m, 392)
+	X, Z := ladder(k, u, ladderBits)
+	if Z == fp2Zero {
+		return fp2Zero, ErrNeutralPoint
+	}
+	return fp2mul(X, fp2inv(Z)), nil
+}
+
+// Reports whether u is the u-coordinate of a point on M, as opposed to
+// its quadratic twist
+func uOnCurve(u fp2elt) bool {
+	v2 := fp2mul(fp2mul(u, fp2add(fp2mul(u, fp2add(u, montA)), fp2One)), montBinv)
+	_, ok := fp2invsqrt(v2)
+	return ok
+}
+
+// ScalarBaseMultU computes the u-coordinate of [392*m]G on the
+// Montgomery model, where m is decoded as in ScalarMult.  It returns
+// ErrNeutralPoint if m is a multiple of N, e.g., zero.
+func ScalarBaseMultU(dst, in *[32]byte) error {
+	u, err := dhLadder(decodeScalar(in), basePointU)
+	if err != nil {
+		return err
+	}
+	*dst = encodeFp2(u)
+	return nil
+}
+
+// ScalarMultU computes the u-coordinate of [392*m]P on the Montgomery
+// model, where base is the u-coordinate of P.  It returns
+// ErrInvalidPoint if base is not the u-coordinate of a point on the
+// curve, and ErrNeutralPoint if the result is the neutral point.
+func ScalarMultU(dst, in, base *[32]byte) error {
+	u, ok := decodeFp2(base)
+	if !ok || !uOnCurve(u) {
+		return ErrInvalidPoint
+	}
+
+	r, err := dhLadder(decodeScalar(in), u)
+	if err != nil {
+		return err
+	}
+	*dst = encodeFp2(r)
+	return nil
+}
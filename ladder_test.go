@@ -0,0 +1,96 @@
+package curve4q
+
+import (
+	"crypto/rand"
+	"testing"
+)
+
+func TestLadder(t *testing.T) {
+	TEST_LOOPS := 20
+
+	if u, _, _ := edwardsToMontgomery(affine{Gx, Gy}); u != basePointU {
+		t.Fatalf("failed base point u-coordinate test")
+	}
+
+	var G [32]byte
+	copy(G[:], encode(affine{Gx, Gy}))
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var a, b, A, B, AU, BU [32]byte
+		rand.Read(a[:])
+		rand.Read(b[:])
+
+		// The u-coordinates of the Edwards public keys should match the
+		// ladder public keys
+		ScalarBaseMultEndo(&A, &a)
+		if err := ScalarBaseMultU(&AU, &a); err != nil {
+			t.Fatalf("failed ScalarBaseMultU test: %v", err)
+		}
+		if u, _, err := ToMontgomery(&A); err != nil || u != AU {
+			t.Fatalf("failed ScalarBaseMultU test: %v", err)
+		}
+
+		GU := encodeFp2(basePointU)
+		ScalarMult(&B, &b, &G)
+		if err := ScalarMultU(&BU, &b, &GU); err != nil {
+			t.Fatalf("failed ScalarMultU test: %v", err)
+		}
+		if u, _, err := ToMontgomery(&B); err != nil || u != BU {
+			t.Fatalf("failed ScalarMultU test: %v", err)
+		}
+
+		// Shared secrets should agree with dhEndo
+		Pa, _ := decode(A[:])
+		Q, err := dhEndo(decodeScalar(&b), Pa, nil)
+		if err != nil {
+			t.Fatalf("failed ladder shared secret test: %v", err)
+		}
+		var S [32]byte
+		copy(S[:], encode(Q))
+		expected, _, _ := ToMontgomery(&S)
+
+		var SU [32]byte
+		if err := ScalarMultU(&SU, &b, &AU); err != nil || SU != expected {
+			t.Fatalf("failed ladder shared secret test: %v", err)
+		}
+	}
+
+	// A u-coordinate on the twist should be rejected
+	for i := uint64(1); ; i += 1 {
+		u := fp2elt{fpint(i), fpint(1)}
+		if uOnCurve(u) {
+			continue
+		}
+
+		var m, out [32]byte
+		rand.Read(m[:])
+		in := encodeFp2(u)
+		if ScalarMultU(&out, &m, &in) != ErrInvalidPoint {
+			t.Fatalf("failed twist rejection test")
+		}
+		break
+	}
+
+	// The 2-torsion point (0, 0) is killed by the cofactor
+	var m, out, zero [32]byte
+	rand.Read(m[:])
+	if ScalarMultU(&out, &m, &zero) != ErrNeutralPoint {
+		t.Fatalf("failed low-order point test")
+	}
+
+	// A zero scalar gives the neutral point, even for the base point
+	if ScalarBaseMultU(&out, &zero) != ErrNeutralPoint {
+		t.Fatalf("failed ScalarBaseMultU zero scalar test")
+	}
+}
+
+func BenchmarkScalarBaseMultU(b *testing.B) {
+	m, _ := benchAPIInputs(16)
+	benchOps(b, func(i int) { ScalarBaseMultU(&benchPoint, &m[i%16]) })
+}
+
+func BenchmarkScalarMultU(b *testing.B) {
+	m, _ := benchAPIInputs(16)
+	var u [32]byte
+	ScalarBaseMultU(&u, &m[0])
+	benchOps(b, func(i int) { ScalarMultU(&benchPoint, &m[i%16], &u) })
+}
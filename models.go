@@ -0,0 +1,167 @@
+package curve4q
+
+import (
+	"encoding/binary"
+)
+
+/********** Montgomery and Weierstrass models **********/
+
+// Field elements are encoded as 32 bytes, with the real and imaginary
+// parts as 16-byte little-endian integers less than p
+func encodeFp2(x fp2elt) (out [32]byte) {
+	binary.LittleEndian.PutUint64(out[0:8], x[0][0])
+	binary.LittleEndian.PutUint64(out[8:16], x[0][1])
+	binary.LittleEndian.PutUint64(out[16:24], x[1][0])
+	binary.LittleEndian.PutUint64(out[24:32], x[1][1])
+	return
+}
+
+func decodeFp2(in *[32]byte) (x fp2elt, ok bool) {
+	x[0][0] = binary.LittleEndian.Uint64(in[0:8])
+	x[0][1] = binary.LittleEndian.Uint64(in[8:16])
+	x[1][0] = binary.LittleEndian.Uint64(in[16:24])
+	x[1][1] = binary.LittleEndian.Uint64(in[24:32])
+
+	canonical := func(x fpelt) bool {
+		return x[1] < p1 || (x[1] == p1 && x[0] < p0)
+	}
+	return x, canonical(x[0]) && canonical(x[1])
+}
+
+// u^3 + A*u^2 + u == B*v^2
+func pointOnMontgomery(u, v fp2elt) bool {
+	RHS := fp2mul(u, fp2add(fp2mul(u, fp2add(u, montA)), fp2One))
+	return fp2mul(montB, fp2sqr(v)) == RHS
+}
+
+// y^2 == x^3 + a4*x + a6
+func pointOnWeierstrass(x, y fp2elt) bool {
+	RHS := fp2add(fp2mul(x, fp2add(fp2sqr(x), weierA4)), weierA6)
+	return fp2sqr(y) == RHS
+}
+
+// FourQ is birationally equivalent to the Montgomery curve
+//
+//	M: B*v^2 = u^3 + A*u^2 + u
+//
+// with A = 2(a + d)/(a - d) and B = 4/(a - d), where a = -1, and to the
+// short Weierstrass curve
+//
+//	W: y^2 = x^3 + a4*x + a6
+//
+// with a4 = (3 - A^2)/(3B^2) and a6 = (2A^3 - 9A)/(27B^3).  The maps are
+//
+//	(x, y) -> (u, v) = ((1 + y)/(1 - y), u/x)
+//	(u, v) -> (x, y) = (u/B + A/(3B), v/B)
+//
+// The neutral point of E maps to the point at infinity, and (0, -1) maps
+// to (0, 0) on M.  The constants montA, montB, weierA4 and weierA6, and
+// montBinv and montAdiv3, are generated from these formulas by
+// modelConstants, and are in tables.go.
+func edwardsToMontgomery(P affine) (u, v fp2elt, err error) {
+	if P.X == fp2Zero {
+		if P.Y == fp2One {
+			return fp2Zero, fp2Zero, ErrPointAtInfinity
+		}
+		return fp2Zero, fp2Zero, nil
+	}
+
+	u = fp2mul(fp2add(fp2One, P.Y), fp2inv(fp2sub(fp2One, P.Y)))
+	v = fp2mul(u, fp2inv(P.X))
+	return u, v, nil
+}
+
+func montgomeryToEdwards(u, v fp2elt) (P affine, err error) {
+	if !pointOnMontgomery(u, v) {
+		return affine{}, ErrInvalidPoint
+	}
+	if u == fp2Zero && v == fp2Zero {
+		return affine{fp2Zero, fp2neg(fp2One)}, nil
+	}
+
+	// Since d is not a square, the exceptional points with v = 0 or
+	// u = -1 are not defined over GF(p^2), so this cannot divide by zero
+	P.X = fp2mul(u, fp2inv(v))
+	P.Y = fp2mul(fp2sub(u, fp2One), fp2inv(fp2add(u, fp2One)))
+	return P, nil
+}
+
+// MontgomeryParams returns the coefficients A and B of the Montgomery
+// model of FourQ, B*v^2 = u^3 + A*u^2 + u
+func MontgomeryParams() (A, B [32]byte) {
+	return encodeFp2(montA), encodeFp2(montB)
+}
+
+// WeierstrassParams returns the coefficients a4 and a6 of the short
+// Weierstrass model of FourQ, y^2 = x^3 + a4*x + a6
+func WeierstrassParams() (a4, a6 [32]byte) {
+	return encodeFp2(weierA4), encodeFp2(weierA6)
+}
+
+// ToMontgomery maps the encoded point P to the Montgomery model.  It
+// returns ErrPointAtInfinity for the neutral point.
+func ToMontgomery(P *[32]byte) (u, v [32]byte, err error) {
+	Pa, err := decode(P[:])
+	if err != nil {
+		return u, v, err
+	}
+
+	uf, vf, err := edwardsToMontgomery(Pa)
+	if err != nil {
+		return u, v, err
+	}
+	return encodeFp2(uf), encodeFp2(vf), nil
+}
+
+// FromMontgomery maps the point (u, v) on the Montgomery model back to
+// an encoded point on FourQ
+func FromMontgomery(dst, u, v *[32]byte) error {
+	uf, ok1 := decodeFp2(u)
+	vf, ok2 := decodeFp2(v)
+	if !ok1 || !ok2 {
+		return ErrInvalidPoint
+	}
+
+	P, err := montgomeryToEdwards(uf, vf)
+	if err != nil {
+		return err
+	}
+	encodeTo(dst[:], P)
+	return nil
+}
+
+// ToWeierstrass maps the encoded point P to the short Weierstrass model.
+// It returns ErrPointAtInfinity for the neutral point.
+func ToWeierstrass(P *[32]byte) (x, y [32]byte, err error) {
+	Pa, err := decode(P[:])
+	if err != nil {
+		return x, y, err
+	}
+
+	u, v, err := edwardsToMontgomery(Pa)
+	if err != nil {
+		return x, y, err
+	}
+	xf := fp2add(fp2mul(u, montBinv), fp2mul(montAdiv3, montBinv))
+	yf := fp2mul(v, montBinv)
+	return encodeFp2(xf), encodeFp2(yf), nil
+}
+
+// FromWeierstrass maps the point (x, y) on the short Weierstrass model
+// back to an encoded point on FourQ
+func FromWeierstrass(dst, x, y *[32]byte) error {
+	xf, ok1 := decodeFp2(x)
+	yf, ok2 := decodeFp2(y)
+	if !ok1 || !ok2 || !pointOnWeierstrass(xf, yf) {
+		return ErrInvalidPoint
+	}
+
+	u := fp2sub(fp2mul(montB, xf), montAdiv3)
+	v := fp2mul(montB, yf)
+	P, err := montgomeryToEdwards(u, v)
+	if err != nil {
+		return err
+	}
+	encodeTo(dst[:], P)
+	return nil
+}
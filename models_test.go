@@ -0,0 +1,123 @@
+package curve4q
+
+import (
+	"crypto/rand"
+	"testing"
+)
+
+// Affine addition on the Weierstrass model, for distinct x-coordinates
+func weierstrassAdd(x1, y1, x2, y2 fp2elt) (x3, y3 fp2elt) {
+	l := fp2mul(fp2sub(y2, y1), fp2inv(fp2sub(x2, x1)))
+	x3 = fp2sub(fp2sub(fp2sqr(l), x1), x2)
+	y3 = fp2sub(fp2mul(l, fp2sub(x1, x3)), y1)
+	return
+}
+
+func TestModels(t *testing.T) {
+	TEST_LOOPS := 20
+
+	if _, ok := fp2invsqrt(d); ok {
+		t.Fatalf("failed non-square d test")
+	}
+
+	var G [32]byte
+	copy(G[:], encode(affine{Gx, Gy}))
+	gx, gy, err := ToWeierstrass(&G)
+	if err != nil {
+		t.Fatalf("failed ToWeierstrass test (G): %v", err)
+	}
+	gxf, _ := decodeFp2(&gx)
+	gyf, _ := decodeFp2(&gy)
+
+	P := G
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var m [32]byte
+		rand.Read(m[:])
+		ScalarMult(&P, &m, &P)
+
+		u, v, err := ToMontgomery(&P)
+		uf, _ := decodeFp2(&u)
+		vf, _ := decodeFp2(&v)
+		if err != nil || !pointOnMontgomery(uf, vf) {
+			t.Fatalf("failed ToMontgomery test: %v", err)
+		}
+
+		var Q [32]byte
+		if err := FromMontgomery(&Q, &u, &v); err != nil || Q != P {
+			t.Fatalf("failed Montgomery round-trip test: %v", err)
+		}
+
+		x, y, err := ToWeierstrass(&P)
+		xf, _ := decodeFp2(&x)
+		yf, _ := decodeFp2(&y)
+		if err != nil || !pointOnWeierstrass(xf, yf) {
+			t.Fatalf("failed ToWeierstrass test: %v", err)
+		}
+
+		if err := FromWeierstrass(&Q, &x, &y); err != nil || Q != P {
+			t.Fatalf("failed Weierstrass round-trip test: %v", err)
+		}
+
+		// The map should respect the group law
+		Pa, _ := decode(P[:])
+		R := _R1toAffine(add(_AffineToR1(Pa), _R1toR2(_AffineToR1(affine{Gx, Gy}))))
+		rx, ry, _ := ToWeierstrass((*[32]byte)(encode(R)))
+		sx, sy := weierstrassAdd(xf, yf, gxf, gyf)
+		if encodeFp2(sx) != rx || encodeFp2(sy) != ry {
+			t.Fatalf("failed Weierstrass addition test")
+		}
+	}
+
+	// Exceptional points
+	var O, T2 [32]byte
+	copy(O[:], encode(affine{Ox, Oy}))
+	copy(T2[:], encode(affine{fp2Zero, fp2neg(fp2One)}))
+	if _, _, err := ToMontgomery(&O); err != ErrPointAtInfinity {
+		t.Fatalf("failed ToMontgomery neutral point test: %v", err)
+	}
+	if _, _, err := ToWeierstrass(&O); err != ErrPointAtInfinity {
+		t.Fatalf("failed ToWeierstrass neutral point test: %v", err)
+	}
+
+	u, v, err := ToMontgomery(&T2)
+	if err != nil || u != encodeFp2(fp2Zero) || v != encodeFp2(fp2Zero) {
+		t.Fatalf("failed ToMontgomery 2-torsion test: %v", err)
+	}
+	x, y, err := ToWeierstrass(&T2)
+	var Q [32]byte
+	if err != nil || FromWeierstrass(&Q, &x, &y) != nil || Q != T2 {
+		t.Fatalf("failed Weierstrass 2-torsion test: %v", err)
+	}
+
+	// Invalid inputs
+	one := encodeFp2(fp2One)
+	if FromMontgomery(&Q, &one, &one) != ErrInvalidPoint || FromWeierstrass(&Q, &one, &one) != ErrInvalidPoint {
+		t.Fatalf("failed invalid point test")
+	}
+	pEnc := encodeFp2(fp2elt{p, fpZero})
+	if FromMontgomery(&Q, &pEnc, &v) != ErrInvalidPoint {
+		t.Fatalf("failed non-canonical field element test")
+	}
+}
+
+func BenchmarkToMontgomery(b *testing.B) {
+	_, P := benchAPIInputs(16)
+	benchOps(b, func(i int) { ToMontgomery(&P[i%16]) })
+}
+
+func BenchmarkFromMontgomery(b *testing.B) {
+	_, P := benchAPIInputs(16)
+	u, v, _ := ToMontgomery(&P[0])
+	benchOps(b, func(i int) { FromMontgomery(&benchPoint, &u, &v) })
+}
+
+func BenchmarkToWeierstrass(b *testing.B) {
+	_, P := benchAPIInputs(16)
+	benchOps(b, func(i int) { ToWeierstrass(&P[i%16]) })
+}
+
+func BenchmarkFromWeierstrass(b *testing.B) {
+	_, P := benchAPIInputs(16)
+	x, y, _ := ToWeierstrass(&P[0])
+	benchOps(b, func(i int) { FromWeierstrass(&benchPoint, &x, &y) })
+}
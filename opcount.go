@@ -0,0 +1,42 @@
+//go:build opcount
+
+package curve4q
+
+import "sync/atomic"
+
+// Counts of field operations, for performance analysis.  These are only
+// maintained when building with the "opcount" tag, since updating them
+// on every operation is costly.  The updates are atomic, so concurrent
+// multiplications do not race, but the counts are then totals across
+// all goroutines.
+var fp2M, fp2S, fp2A, fp2I atomic.Int64
+
+const opcountEnabled = true
+
+func countM(n int64) { fp2M.Add(n) }
+func countS(n int64) { fp2S.Add(n) }
+func countA(n int64) { fp2A.Add(n) }
+func countI(n int64) { fp2I.Add(n) }
+
+func clearCounters() {
+	fp2M.Store(0)
+	fp2S.Store(0)
+	fp2A.Store(0)
+	fp2I.Store(0)
+}
+
+func counters() opCounts {
+	return opCounts{M: fp2M.Load(), S: fp2S.Load(), A: fp2A.Load(), I: fp2I.Load()}
+}
+
+// OpCounts reports the number of GF(p^2) multiplications, squarings,
+// additions and inversions since the last call to ResetOpCounts.  It is
+// only available with the "opcount" tag, for use by cmd/fourq-bench.
+func OpCounts() (M, S, A, I int64) {
+	c := counters()
+	return c.M, c.S, c.A, c.I
+}
+
+func ResetOpCounts() {
+	clearCounters()
+}
@@ -0,0 +1,18 @@
+//go:build !opcount
+
+package curve4q
+
+// Without the "opcount" tag, field operations are not counted, and
+// counters always reports zero.
+const opcountEnabled = false
+
+func countM(n int64) {}
+func countS(n int64) {}
+func countA(n int64) {}
+func countI(n int64) {}
+
+func clearCounters() {}
+
+func counters() opCounts {
+	return opCounts{}
+}
@@ -0,0 +1,57 @@
+package curve4q
+
+// The hard-coded curve parameters, for the checks in internal/params.  This
+// has the layout of params.Constants, which this package only imports in
+// tests and in the "params" build of params_export.go, so that the library
+// does not depend on math/big.
+type paramConstants struct {
+	P                                  [2]uint64
+	N, CofactorInv                     [4]uint64
+	Cofactor                           uint64
+	D, Dinv, Gx, Gy                    [32]byte
+	LambdaPhi, LambdaPsi, LambdaPhiPsi [4]uint64
+
+	B [4][4]uint64
+	C [4]uint64
+	L [4][4]uint64
+
+	Phi, Psi, TauDualTau func(P [32]byte) ([32]byte, error)
+}
+
+// Applies an endomorphism to a point encoding
+func paramPointMap(f func(r1) r1) func([32]byte) ([32]byte, error) {
+	return func(in [32]byte) (out [32]byte, err error) {
+		P, err := decode(in[:])
+		if err != nil {
+			return
+		}
+		encodeTo(out[:], _R1toAffine(f(_AffineToR1(P))))
+		return
+	}
+}
+
+func auditConstants() paramConstants {
+	return paramConstants{
+		P:            p,
+		N:            N,
+		CofactorInv:  cofactorInv,
+		Cofactor:     cofactor,
+		D:            encodeFp2(d),
+		Dinv:         encodeFp2(dinv),
+		Gx:           encodeFp2(Gx),
+		Gy:           encodeFp2(Gy),
+		LambdaPhi:    lambdaPhi,
+		LambdaPsi:    lambdaPsi,
+		LambdaPhiPsi: lambdaPhiPsi,
+
+		B: [4][4]uint64{b1, b2, b3, b4},
+		C: c,
+		L: [4][4]uint64{L1, L2, L3, L4},
+
+		Phi: paramPointMap(phi),
+		Psi: paramPointMap(psi),
+		TauDualTau: paramPointMap(func(P r1) r1 {
+			return tauDual(tau(_R1toR4(P)))
+		}),
+	}
+}
@@ -0,0 +1,11 @@
+//go:build params
+
+package curve4q
+
+import "github.com/bifurcation/curve4q/internal/params"
+
+// ParamConstants exposes the hard-coded curve parameters to
+// cmd/fourq-params, so it is only built with the "params" tag.
+func ParamConstants() params.Constants {
+	return params.Constants(auditConstants())
+}
@@ -0,0 +1,52 @@
+package curve4q
+
+import (
+	"testing"
+
+	"github.com/bifurcation/curve4q/internal/params"
+)
+
+// The checks of the hard-coded curve parameters are in internal/params,
+// and are shared with cmd/fourq-params.  A summary of the checks is
+// printed by
+//
+//	go test -run TestAuditParams -v
+
+func TestAuditParams(t *testing.T) {
+	for _, check := range params.Audit(params.Constants(auditConstants())) {
+		t.Run(check.Name, func(t *testing.T) {
+			if check.Err != nil {
+				t.Fatalf("failed parameter check: %v", check.Err)
+			}
+		})
+	}
+}
+
+func TestAuditParamsDetectsErrors(t *testing.T) {
+	failed := func(k params.Constants, name string) bool {
+		for _, check := range params.Audit(k) {
+			if check.Name == name {
+				return check.Err != nil
+			}
+		}
+		return false
+	}
+
+	k := params.Constants(auditConstants())
+	k.B[1][2] += 1
+	if !failed(k, "b1, ..., b4 are in the kernel of the decomposition") {
+		t.Fatalf("failed kernel check test")
+	}
+
+	k = params.Constants(auditConstants())
+	k.L[3][0] += 1
+	if !failed(k, "L1, ..., L4 are the rounded lattice coordinates") {
+		t.Fatalf("failed rounding check test")
+	}
+
+	k = params.Constants(auditConstants())
+	k.Psi = k.Phi
+	if !failed(k, "psi(P) = [lambdaPsi]P") {
+		t.Fatalf("failed eigenvalue check test")
+	}
+}
@@ -0,0 +1,473 @@
+package curve4q
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/bifurcation/curve4q/internal/ref"
+)
+
+// Differential tests against the math/big reference implementation
+
+func panics(f func()) (p bool) {
+	defer func() {
+		p = recover() != nil
+	}()
+	f()
+	return
+}
+
+func randBig(max *big.Int) *big.Int {
+	k, _ := rand.Int(rand.Reader, max)
+	return k
+}
+
+func fpToBig(x fpelt) *big.Int {
+	z := new(big.Int).SetUint64(x[1])
+	z.Lsh(z, 64)
+	return z.Add(z, new(big.Int).SetUint64(x[0]))
+}
+
+func bigToFp(x *big.Int) fpelt {
+	return fpelt{x.Uint64(), new(big.Int).Rsh(x, 64).Uint64()}
+}
+
+func scalarToBigInt(x scalar) *big.Int {
+	buf := encodeScalar(x)
+	return ref.FromLE(buf[:])
+}
+
+// Conversions to and from the reference implementation
+func toRefFp2(x fp2elt) ref.Fp2 {
+	return ref.Fp2{A: fpToBig(x[0]), B: fpToBig(x[1])}
+}
+
+func fromRefFp2(x ref.Fp2) fp2elt {
+	return fp2elt{bigToFp(x.A), bigToFp(x.B)}
+}
+
+func toRefPoint(P affine) ref.Point {
+	return ref.Point{X: toRefFp2(P.X), Y: toRefFp2(P.Y)}
+}
+
+func fromRefPoint(P ref.Point) affine {
+	return affine{fromRefFp2(P.X), fromRefFp2(P.Y)}
+}
+
+// Points of order N, and the same points plus a torsion component
+func refTestPoints(n int) (points []ref.Point) {
+	T := fromRefPoint(ref.G)
+	for _, S := range smallOrderPoints {
+		if (&Point{p: S}).TorsionComponent().p == S && toRefPoint(S).ScalarMult(big.NewInt(56)).Equal(ref.Neutral) &&
+			!toRefPoint(S).ScalarMult(big.NewInt(28)).Equal(ref.Neutral) {
+			T = S
+			break
+		}
+	}
+
+	points = []ref.Point{ref.G}
+	for i := 0; i < n; i += 1 {
+		P := ref.G.ScalarMult(randBig(ref.N))
+		points = append(points, P, P.Add(toRefPoint(T)))
+	}
+	return
+}
+
+// Edge-case and random scalars, as 32-byte little-endian strings
+func refTestScalars(n int) (scalars [][32]byte) {
+	Nb := scalarToBigInt(N)
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 244), big.NewInt(1))
+	for _, k := range []*big.Int{
+		big.NewInt(1),
+		big.NewInt(2),
+		new(big.Int).Sub(Nb, big.NewInt(1)),
+		Nb,
+		new(big.Int).Add(Nb, big.NewInt(1)),
+		new(big.Int).Lsh(Nb, 1),
+		mask,
+	} {
+		scalars = append(scalars, [32]byte(ref.ToLE(k, 32)))
+	}
+
+	var ones [32]byte
+	for i := range ones {
+		ones[i] = 0xff
+	}
+	scalars = append(scalars, ones)
+
+	for i := 0; i < n; i += 1 {
+		var m [32]byte
+		rand.Read(m[:])
+		scalars = append(scalars, m)
+	}
+	return
+}
+
+// The scalar as interpreted by decodeScalar: the whole little-endian
+// integer, reduced modulo N
+func refScalar(m *[32]byte) *big.Int {
+	return new(big.Int).Mod(ref.FromLE(m[:]), ref.N)
+}
+
+// The result of ScalarMult, or false if it is the neutral point
+func refDH(m *big.Int, P ref.Point) ([32]byte, bool) {
+	Q := P.ScalarMult(new(big.Int).Mul(m, ref.Cofactor))
+	return ref.Encode(Q), !Q.Equal(ref.Neutral)
+}
+
+func TestRefEncodeDecode(t *testing.T) {
+	TEST_LOOPS := 1000
+
+	points := refTestPoints(10)
+	for _, S := range smallOrderPoints {
+		points = append(points, toRefPoint(S))
+	}
+	for _, P := range points {
+		for _, Q := range []ref.Point{P, P.Neg()} {
+			expected := ref.Encode(Q)
+			if enc := encode(fromRefPoint(Q)); [32]byte(enc) != expected {
+				t.Fatalf("failed encode test")
+			}
+
+			dec, err := decode(expected[:])
+			if err != nil || !toRefPoint(dec).Equal(Q) {
+				t.Fatalf("failed decode test")
+			}
+		}
+	}
+
+	// Random strings, non-canonical y-coordinates, and the sign bit set
+	// on a point with x = 0
+	var bufs [][32]byte
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var buf [32]byte
+		rand.Read(buf[:])
+		bufs = append(bufs, buf)
+	}
+	for _, y := range [][2]*big.Int{
+		{ref.P, big.NewInt(0)},
+		{big.NewInt(1), ref.P},
+		{ref.P, ref.P},
+		{big.NewInt(1), big.NewInt(0)},
+	} {
+		var buf [32]byte
+		copy(buf[0:16], ref.ToLE(y[0], 16))
+		copy(buf[16:32], ref.ToLE(y[1], 16))
+		bufs = append(bufs, buf)
+		buf[31] |= 0x80
+		bufs = append(bufs, buf)
+	}
+
+	for _, buf := range bufs {
+		P, err := decode(buf[:])
+		Q, ok := ref.Decode(buf[:])
+		if (err == nil) != ok || (ok && !toRefPoint(P).Equal(Q)) {
+			t.Fatalf("failed decode agreement test: %x", buf)
+		}
+	}
+}
+
+func TestRefArith(t *testing.T) {
+	points := refTestPoints(5)
+	for _, S := range smallOrderPoints[:20] {
+		points = append(points, toRefPoint(S))
+	}
+
+	for i, P := range points {
+		P1 := _AffineToR1(fromRefPoint(P))
+		if !toRefPoint(_R1toAffine(dbl(P1))).Equal(P.Double()) {
+			t.Fatalf("failed dbl test")
+		}
+
+		Q := points[(i+1)%len(points)]
+		Q1 := _AffineToR1(fromRefPoint(Q))
+		expected := P.Add(Q)
+		if !toRefPoint(_R1toAffine(add_core(_R1toR3(P1), _R1toR2(Q1)))).Equal(expected) {
+			t.Fatalf("failed add_core test")
+		}
+		if !toRefPoint(_R1toAffine(add(P1, _R1toR2(Q1)))).Equal(expected) {
+			t.Fatalf("failed add test")
+		}
+		if !toRefPoint(_R1toAffine(add(P1, _R1toR2(P1)))).Equal(P.Double()) {
+			t.Fatalf("failed add doubling test")
+		}
+	}
+}
+
+func TestRefMul(t *testing.T) {
+	Nb := scalarToBigInt(N)
+	points := refTestPoints(2)
+	scalars := refTestScalars(4)
+
+	var ms []scalar
+	for _, k := range scalars {
+		ms = append(ms, decodeScalar(&k))
+	}
+	ms = append(ms, scalar{0, 0, 0, 0}, N, ssubi(N, 1), ssubi(N, 100))
+
+	for _, P := range points {
+		// The optimized multiplications assume that P has order N
+		if !P.ScalarMult(Nb).Equal(ref.Neutral) {
+			continue
+		}
+
+		P1 := _AffineToR1(fromRefPoint(P))
+		for _, m := range ms {
+			expected := P.ScalarMult(scalarToBigInt(m))
+
+			if !toRefPoint(_R1toAffine(mulEndo(m, P1, nil))).Equal(expected) {
+				t.Fatalf("failed mulEndo test")
+			}
+			if !toRefPoint(_R1toAffine(mulEndoVarTime(m, P1, nil))).Equal(expected) {
+				t.Fatalf("failed mulEndoVarTime test")
+			}
+			for w := uint(windowMin); w <= windowMax; w += 1 {
+				Q := mulWindowed(m, P1, tableWindowed(P1, w))
+				if !toRefPoint(_R1toAffine(Q)).Equal(expected) {
+					t.Fatalf("failed mulWindowed test (w=%d)", w)
+				}
+			}
+		}
+	}
+}
+
+func TestRefPublicAPI(t *testing.T) {
+	points := refTestPoints(1)
+	scalars := refTestScalars(3)
+	var zero [32]byte
+	scalars = append(scalars, zero)
+
+	var mm Multiplier
+	for _, P := range points {
+		base := ref.Encode(P)
+		pre, err := NewPrecomputedPoint(&base)
+		if err != nil {
+			t.Fatalf("failed NewPrecomputedPoint test: %v", err)
+		}
+		pt, err := DecodePoint(&base)
+		if err != nil || pt.Bytes() != base {
+			t.Fatalf("failed DecodePoint test")
+		}
+
+		for _, m := range scalars {
+			k := refScalar(&m)
+			expected, ok := refDH(k, P)
+			expectedBase, _ := refDH(k, ref.G)
+
+			results := map[string]func(dst *[32]byte) error{
+				"ScalarMult": func(dst *[32]byte) error {
+					return ScalarMult(dst, &m, &base)
+				},
+				"ScalarMultVarTime": func(dst *[32]byte) error {
+					return ScalarMultVarTime(dst, &m, &base)
+				},
+				"ScalarMultWin": func(dst *[32]byte) error {
+					return ScalarMultWin(dst, m[:], &base, 0)
+				},
+				"ScalarMultPrecomputed": func(dst *[32]byte) error {
+					return ScalarMultPrecomputed(dst, &m, pre)
+				},
+				"ScalarMultPoint": func(dst *[32]byte) error {
+					return ScalarMultPoint(dst, &m, pt)
+				},
+				"Multiplier.ScalarMult": func(dst *[32]byte) error {
+					return mm.ScalarMult(dst, &m, &base)
+				},
+			}
+			for _, randomize := range []bool{false, true} {
+				for _, detect := range []bool{false, true} {
+					key := NewPrivateKey(&m)
+					key.Randomize, key.DetectFaults = randomize, detect
+					flags := fmt.Sprintf("(%v, %v)", randomize, detect)
+					results["PrivateKey.ScalarMult"+flags] = func(dst *[32]byte) error {
+						return key.ScalarMult(dst, &base)
+					}
+					results["PrivateKey.ScalarMultPrecomputed"+flags] = func(dst *[32]byte) error {
+						return key.ScalarMultPrecomputed(dst, pre)
+					}
+				}
+			}
+
+			for name, f := range results {
+				var dst [32]byte
+
+				var err error
+				if panics(func() { err = f(&dst) }) {
+					t.Fatalf("failed %s test: panic", name)
+				}
+				if !ok {
+					if err != ErrNeutralPoint {
+						t.Fatalf("failed %s neutral point test: %v", name, err)
+					}
+					continue
+				}
+				if err != nil || dst != expected {
+					t.Fatalf("failed %s test: %v", name, err)
+				}
+			}
+
+			if !ok {
+				continue
+			}
+
+			baseFns := map[string]func(dst *[32]byte) error{
+				"ScalarBaseMultWin":     func(dst *[32]byte) error { return ScalarBaseMultWin(dst, &m) },
+				"ScalarBaseMultEndo":    func(dst *[32]byte) error { return ScalarBaseMultEndo(dst, &m) },
+				"ScalarBaseMultVarTime": func(dst *[32]byte) error { return ScalarBaseMultVarTime(dst, &m) },
+				"Multiplier.ScalarBaseMult": func(dst *[32]byte) error {
+					return mm.ScalarBaseMult(dst, &m)
+				},
+				"PrivateKey.ScalarBaseMult": func(dst *[32]byte) error {
+					return NewPrivateKey(&m).ScalarBaseMult(dst)
+				},
+			}
+			for name, f := range baseFns {
+				var dst [32]byte
+				if err := f(&dst); err != nil || dst != expectedBase {
+					t.Fatalf("failed %s test: %v", name, err)
+				}
+			}
+
+			// x-only ladder: the result is the u-coordinate of the same
+			// point on the Montgomery model
+			u, _, err := ToMontgomery(&base)
+			expectedU, _, _ := ToMontgomery(&expected)
+			var dstU [32]byte
+			if err != nil || ScalarMultU(&dstU, &m, &u) != nil || dstU != expectedU {
+				t.Fatalf("failed ScalarMultU test")
+			}
+			expectedU, _, _ = ToMontgomery(&expectedBase)
+			if ScalarBaseMultU(&dstU, &m) != nil || dstU != expectedU {
+				t.Fatalf("failed ScalarBaseMultU test")
+			}
+		}
+	}
+
+	// Batch APIs
+	var jobs []BatchJob
+	var bufs [][32]byte
+	for _, P := range points {
+		for _, m := range scalars {
+			jobs = append(jobs, BatchJob{Scalar: m, Point: ref.Encode(P)})
+		}
+		bufs = append(bufs, ref.Encode(P))
+	}
+	out, errs := ScalarMultBatch(context.Background(), jobs)
+	for i, job := range jobs {
+		P, _ := ref.Decode(job.Point[:])
+		expected, ok := refDH(refScalar(&job.Scalar), P)
+		if (ok && (errs[i] != nil || out[i] != expected)) || (!ok && errs[i] != ErrNeutralPoint) {
+			t.Fatalf("failed ScalarMultBatch test [%d]", i)
+		}
+	}
+
+	decoded, errs := DecodeBatch(bufs)
+	for i, P := range points {
+		if errs[i] != nil || !toRefPoint(decoded[i].p).Equal(P) {
+			t.Fatalf("failed DecodeBatch test [%d]", i)
+		}
+	}
+}
+
+func TestRefEndoAPI(t *testing.T) {
+	Nb := scalarToBigInt(N)
+	lphi, lpsi := Eigenvalues()
+	lphiB, lpsiB := ref.FromLE(lphi[:]), ref.FromLE(lpsi[:])
+
+	for _, P := range refTestPoints(2) {
+		if !P.ScalarMult(Nb).Equal(ref.Neutral) {
+			continue
+		}
+
+		base := ref.Encode(P)
+		var dst [32]byte
+		if Phi(&dst, &base) != nil || dst != ref.Encode(P.ScalarMult(lphiB)) {
+			t.Fatalf("failed Phi test")
+		}
+		if Psi(&dst, &base) != nil || dst != ref.Encode(P.ScalarMult(lpsiB)) {
+			t.Fatalf("failed Psi test")
+		}
+	}
+
+	for _, k := range refTestScalars(10) {
+		a := Decompose(&k)
+		sum := new(big.Int).SetUint64(a[0])
+		sum.Add(sum, new(big.Int).Mul(new(big.Int).SetUint64(a[1]), lphiB))
+		sum.Add(sum, new(big.Int).Mul(new(big.Int).SetUint64(a[2]), lpsiB))
+		lphipsi := new(big.Int).Mul(lphiB, lpsiB)
+		sum.Add(sum, new(big.Int).Mul(new(big.Int).SetUint64(a[3]), lphipsi))
+
+		expected := new(big.Int).Mod(ref.FromLE(k[:]), Nb)
+		if sum.Mod(sum, Nb).Cmp(expected) != 0 {
+			t.Fatalf("failed Decompose test")
+		}
+	}
+}
+
+func TestRefModels(t *testing.T) {
+	a := ref.Int(-1)
+	A := ref.Int(2).Mul(a.Add(ref.D)).Mul(a.Sub(ref.D).Inv())
+	B := ref.Int(4).Mul(a.Sub(ref.D).Inv())
+	mA, mB := MontgomeryParams()
+	if mA != refFp2Bytes(A) || mB != refFp2Bytes(B) {
+		t.Fatalf("failed Montgomery parameter test")
+	}
+
+	for _, P := range refTestPoints(3) {
+		u := ref.Int(1).Add(P.Y).Mul(ref.Int(1).Sub(P.Y).Inv())
+		v := u.Mul(P.X.Inv())
+		x := u.Add(A.Mul(ref.Int(3).Inv())).Mul(B.Inv())
+		y := v.Mul(B.Inv())
+
+		base := ref.Encode(P)
+		gu, gv, err := ToMontgomery(&base)
+		if err != nil || gu != refFp2Bytes(u) || gv != refFp2Bytes(v) {
+			t.Fatalf("failed ToMontgomery test")
+		}
+		gx, gy, err := ToWeierstrass(&base)
+		if err != nil || gx != refFp2Bytes(x) || gy != refFp2Bytes(y) {
+			t.Fatalf("failed ToWeierstrass test")
+		}
+
+		var dst [32]byte
+		if FromMontgomery(&dst, &gu, &gv) != nil || dst != base {
+			t.Fatalf("failed FromMontgomery test")
+		}
+		if FromWeierstrass(&dst, &gx, &gy) != nil || dst != base {
+			t.Fatalf("failed FromWeierstrass test")
+		}
+	}
+}
+
+func refFp2Bytes(x ref.Fp2) [32]byte {
+	return encodeFp2(fromRefFp2(x))
+}
+
+func TestRefTorsion(t *testing.T) {
+	Nb := scalarToBigInt(N)
+	u := new(big.Int).ModInverse(Nb, ref.Cofactor)
+	uN := new(big.Int).Mul(u, Nb)
+
+	points := refTestPoints(3)
+	for _, S := range SmallOrderPoints() {
+		if !toRefPoint(S.p).ScalarMult(ref.Cofactor).Equal(ref.Neutral) {
+			t.Fatalf("failed SmallOrderPoints test")
+		}
+	}
+
+	for _, P := range append(points, toRefPoint(smallOrderPoints[7])) {
+		pt := &Point{p: fromRefPoint(P)}
+		if !toRefPoint(pt.ClearCofactor().p).Equal(P.ScalarMult(ref.Cofactor)) {
+			t.Fatalf("failed ClearCofactor test")
+		}
+		if !toRefPoint(pt.TorsionComponent().p).Equal(P.ScalarMult(uN)) {
+			t.Fatalf("failed TorsionComponent test")
+		}
+		if pt.IsSmallOrder() != P.ScalarMult(ref.Cofactor).Equal(ref.Neutral) {
+			t.Fatalf("failed IsSmallOrder test")
+		}
+	}
+}
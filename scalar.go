@@ -40,11 +40,12 @@ func ssubi(x scalar, y int) (z scalar) {
 	return sselect(neg, xp, xm)
 }
 
-func srsh4(x scalar) (z scalar) {
-	z[3] = x[3] >> 4
-	z[2] = (x[2] >> 4) | ((x[3] & 0x0f) << 60)
-	z[1] = (x[1] >> 4) | ((x[2] & 0x0f) << 60)
-	z[0] = (x[0] >> 4) | ((x[1] & 0x0f) << 60)
+// Note: Requires 0 < n < 64
+func srsh(x scalar, n uint) (z scalar) {
+	z[3] = x[3] >> n
+	z[2] = (x[2] >> n) | (x[3] << (64 - n))
+	z[1] = (x[1] >> n) | (x[2] << (64 - n))
+	z[0] = (x[0] >> n) | (x[1] << (64 - n))
 	return
 }
 
@@ -87,14 +88,25 @@ func smultrunc(x scalar, y scalar) uint64 {
 	return hi
 }
 
-// Note: Super-basic implementation
-// Note: Not constant-time
-func smodN(x scalar) (y scalar) {
-	y = x
-	for y[3] >= N[3] {
-		y = ssub(y, N)
+// Reduces x modulo N in constant time.  Since x < 2^256 < 2^11*N, it
+// suffices to conditionally subtract 2^10*N, 2^9*N, ..., N in turn.
+func smodN(x scalar) scalar {
+	t := N
+	for i := 0; i < 10; i += 1 {
+		t = sadd(t, t)
 	}
-	return
+
+	for i := 10; i >= 0; i -= 1 {
+		var c uint64
+		var z scalar
+		c, z[0] = wsub(x[0], t[0], 0)
+		c, z[1] = wsub(x[1], t[1], c)
+		c, z[2] = wsub(x[2], t[2], c)
+		c, z[3] = wsub(x[3], t[3], c)
+		x = sselect(c, x, z)
+		t = srsh(t, 1)
+	}
+	return x
 }
 
 func sselect(c uint64, x1 scalar, x0 scalar) (y scalar) {
@@ -105,3 +117,36 @@ func sselect(c uint64, x1 scalar, x0 scalar) (y scalar) {
 	y[3] = x0[3] ^ (m & (x1[3] ^ x0[3]))
 	return
 }
+
+// z = x + y mod N, for x, y < N
+func saddmodN(x, y scalar) scalar {
+	var c uint64
+	var w scalar
+	z := sadd(x, y)
+	c, w[0] = wsub(z[0], N[0], 0)
+	c, w[1] = wsub(z[1], N[1], c)
+	c, w[2] = wsub(z[2], N[2], c)
+	c, w[3] = wsub(z[3], N[3], c)
+	return sselect(c, z, w)
+}
+
+// z = x * y mod N, for x < N
+func smulmodN(x, y scalar) (z scalar) {
+	for i := 255; i >= 0; i -= 1 {
+		z = saddmodN(z, z)
+		bit := (y[i/64] >> uint(i%64)) & 1
+		z = sselect(bit, saddmodN(z, x), z)
+	}
+	return
+}
+
+// Reduces a little-endian integer of any length modulo N
+func sreduce(in []byte) (z scalar) {
+	one := scalar{1, 0, 0, 0}
+	for i := 8*len(in) - 1; i >= 0; i -= 1 {
+		bit := uint64(in[i/8]>>uint(i%8)) & 1
+		z = saddmodN(z, z)
+		z = sselect(bit, saddmodN(z, one), z)
+	}
+	return
+}
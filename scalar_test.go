@@ -2,7 +2,11 @@ package curve4q
 
 import (
 	"fmt"
+	"math/big"
+	"math/rand"
 	"testing"
+
+	"github.com/bifurcation/curve4q/internal/ref"
 )
 
 func (x scalar) String() string {
@@ -47,12 +51,17 @@ func TestSSubI(t *testing.T) {
 	}
 }
 
-func TestSRsh4(t *testing.T) {
+func TestSRsh(t *testing.T) {
 	x := scalar{0xffffffffffffffff, 0xeeeeeeeeeeeeeeee, 0xdddddddddddddddd, 0xcccccccccccccccc}
 	y := scalar{0xefffffffffffffff, 0xdeeeeeeeeeeeeeee, 0xcddddddddddddddd, 0x0ccccccccccccccc}
 
-	if y != srsh4(x) {
-		t.Fatalf("failed srsh4 test")
+	if y != srsh(x, 4) {
+		t.Fatalf("failed srsh test (4)")
+	}
+
+	y = scalar{0xddffffffffffffff, 0xbbdddddddddddddd, 0x99bbbbbbbbbbbbbb, 0x0199999999999999}
+	if y != srsh(x, 7) {
+		t.Fatalf("failed srsh test (7)")
 	}
 }
 
@@ -78,4 +87,49 @@ func TestSModN(t *testing.T) {
 	if y != smodN(x) {
 		t.Fatalf("failed smodN test ((N << 4) + 5)")
 	}
+
+	// Values below N that share its top word
+	x = ssubi(N, 1)
+	if x != smodN(x) {
+		t.Fatalf("failed smodN test (N - 1)")
+	}
+
+	// 2^256 - 1 = 1568*N + r
+	x = scalar{_m, _m, _m, _m}
+	_, y = smulw(N, 1568)
+	if sadd(y, smodN(x)) != x || smodN(smodN(x)) != smodN(x) {
+		t.Fatalf("failed smodN test (2^256 - 1)")
+	}
+}
+
+func TestSMulModN(t *testing.T) {
+	Nb := scalarToBigInt(N)
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		x := smodN(randScalar())
+		y := randScalar()
+
+		zb := big.NewInt(0).Add(scalarToBigInt(x), scalarToBigInt(x))
+		zb.Mod(zb, Nb)
+		if scalarToBigInt(saddmodN(x, x)).Cmp(zb) != 0 {
+			t.Fatalf("failed saddmodN test")
+		}
+
+		zb = big.NewInt(0).Mul(scalarToBigInt(x), scalarToBigInt(y))
+		zb.Mod(zb, Nb)
+		if scalarToBigInt(smulmodN(x, y)).Cmp(zb) != 0 {
+			t.Fatalf("failed smulmodN test")
+		}
+	}
+}
+
+func TestSReduce(t *testing.T) {
+	for _, n := range []int{0, 1, 31, 32, 33, 64} {
+		buf := make([]byte, n)
+		rand.Read(buf)
+
+		xb := new(big.Int).Mod(ref.FromLE(buf), ref.N)
+		if scalarToBigInt(sreduce(buf)).Cmp(xb) != 0 {
+			t.Fatalf("failed sreduce test (%d bytes)", n)
+		}
+	}
 }
@@ -0,0 +1,3385 @@
+// Code generated by "go test -run=TestTables -update"; DO NOT EDIT.
+
+package curve4q
+
+// Parameters of basePointTableComb, which is only generated if combW > 0;
+// see tableComb
+const (
+	combW = 0
+	combV = 0
+)
+
+var (
+	basePoint392 = r1{
+		fp2elt{fpelt{0xe3b3866e3fe19bb1, 0x09eb956c0fcf3322}, fpelt{0xea0dfc853775fb2a, 0x446031bfce798144}},
+		fp2elt{fpelt{0xaeb59a34e2cb7357, 0x1f3d658e67432693}, fpelt{0x954890e8434575a1, 0x3b7fcc824f8cc53a}},
+		fp2elt{fpelt{0xbae31c2d476f70e2, 0x166ffd839778aa46}, fpelt{0xdab40e6f74ec0abd, 0x69f1a67f4f995481}},
+		fp2elt{fpelt{0xc359578ec13770b0, 0x0fb0ba473407c8d7}, fpelt{0x5f3cb8c7230c4b81, 0x4c251221b60b6790}},
+		fp2elt{fpelt{0xa40162db57f07ca6, 0x2102b3162decebb1}, fpelt{0xc42e836d90d9fe02, 0x42dc4dd558ab819a}},
+	}
+
+	basePointTableWin = []r2{
+		{
+			fp2elt{fpelt{0x926920a322ad0f08, 0x2928fafa771259b6}, fpelt{0x7f568d6d7abb70cb, 0x7fdffe421e06467f}},
+			fp2elt{fpelt{0xcb0213c6a2e9d7a6, 0x1551d0225773f370}, fpelt{0xab3a94630bcf7a76, 0x771f9ac2811343f5}},
+			fp2elt{fpelt{0x75c6385a8edee1c4, 0x2cdffb072ef1548d}, fpelt{0xb5681cdee9d8157b, 0x53e34cfe9f32a903}},
+			fp2elt{fpelt{0x780d4e280c9e6e86, 0x23eea1558efcf058}, fpelt{0x61e3943de724384a, 0x5ca456b4ec78e882}},
+		},
+		{
+			fp2elt{fpelt{0xd4f9ba78b9193034, 0x34ef5a45d2a1c5e4}, fpelt{0xd2a57d4e9bc654c1, 0x38b832b31ebe0e7d}},
+			fp2elt{fpelt{0x1b07d0d0f2ec367f, 0x530327dc90431cd8}, fpelt{0x3dae1a872f1b5cdf, 0x5b1f4efde26fc644}},
+			fp2elt{fpelt{0xf62cc0eee212bca2, 0x113f63a194c31925}, fpelt{0xf9e13828e3cc42d6, 0x6beeed53bb74892c}},
+			fp2elt{fpelt{0x1f9db02e4f20db5d, 0x60a99efc7203987f}, fpelt{0x3fefbb66eeda0ced, 0x034a15e695472b03}},
+		},
+		{
+			fp2elt{fpelt{0x3743ce3e9e85a174, 0x13d22d1d7b477c8d}, fpelt{0x6058dc7a7265f283, 0x7ae31739ef456ec3}},
+			fp2elt{fpelt{0xc32c72a04019db34, 0x20c45f2ef20c798d}, fpelt{0x91c5355b879ec7e1, 0x610148dedc549c63}},
+			fp2elt{fpelt{0xa469d6758086076b, 0x3d3a74f769e440f4}, fpelt{0x7f80f24eb140d778, 0x484f73507c6a16d8}},
+			fp2elt{fpelt{0x087df9910a3f5ab6, 0x0976ea963f436362}, fpelt{0xa4c7a6fc43f199ca, 0x3daac9cd7e89d44b}},
+		},
+		{
+			fp2elt{fpelt{0x028913ae71ffe27b, 0x627617c27e6520c9}, fpelt{0x55ab6d2da7a76f62, 0x1fa3bed64084964d}},
+			fp2elt{fpelt{0x5299f26e8d3d5594, 0x4bb54789c195b5ff}, fpelt{0xe0b854623e18591b, 0x6f50085307285d8f}},
+			fp2elt{fpelt{0x7263fa1049f5e358, 0x418709871952f57b}, fpelt{0xa7116cba6f2d4967, 0x674acdd83d570a36}},
+			fp2elt{fpelt{0x89b77eadc4c27bf3, 0x3204e851af69cde0}, fpelt{0x48bdbba493ed03a5, 0x2490ad20cef3631b}},
+		},
+		{
+			fp2elt{fpelt{0xbd08c33fa5e6a11c, 0x62ea7ae46c35307b}, fpelt{0x110bbc0bb0c1bbbf, 0x4d4b4090122bb188}},
+			fp2elt{fpelt{0x9278225bf5e88e42, 0x78d0ca8b46dfd49c}, fpelt{0xea6c9a5a853bf61d, 0x3406eecd9a495f05}},
+			fp2elt{fpelt{0x7f41eaabcd2d6238, 0x3a093e27a1986f45}, fpelt{0x0e9992ffe3019cea, 0x410ce3335ce45222}},
+			fp2elt{fpelt{0x2e402feae8562095, 0x093d3503941c70fd}, fpelt{0x0311af84d4de07de, 0x2be5fd81136eb926}},
+		},
+		{
+			fp2elt{fpelt{0xae9d4d9e0a9dda00, 0x4439cb004cc5cf88}, fpelt{0xb4705976b432c5c4, 0x6f7b03eea7572b7d}},
+			fp2elt{fpelt{0x0329bc1adf1b90ea, 0x7d90c3b66d69b628}, fpelt{0x192d89f82d588d3f, 0x2844721c94d528d1}},
+			fp2elt{fpelt{0x1a56e1e87563339e, 0x1a096b95f60e247f}, fpelt{0xc30b7908d5ac1b81, 0x3e455c2c30ce8ec6}},
+			fp2elt{fpelt{0x413d74d10c9895d8, 0x745f4b4f205e2566}, fpelt{0xd55a1d6447b422b4, 0x4bbf21dde9943cc3}},
+		},
+		{
+			fp2elt{fpelt{0xb907d0e38e4099d6, 0x340c1f664a394d41}, fpelt{0xe6b7700a8bc6cb69, 0x3b6ed98cb677b109}},
+			fp2elt{fpelt{0x74dcd19a65a1a4ab, 0x37ce901479a6f9ee}, fpelt{0xd49bd7cb95e277b9, 0x218dfda5fb3d0853}},
+			fp2elt{fpelt{0x54c953302358093f, 0x698f22b5b13fe11d}, fpelt{0xc3c23e4cb43fba5b, 0x45b8b86323cbc58c}},
+			fp2elt{fpelt{0xec6fc115d6d18d37, 0x2336d93f3a21495f}, fpelt{0xf5543b235e7e4a68, 0x17283627ae77ba0f}},
+		},
+		{
+			fp2elt{fpelt{0x9495e38bba6dac19, 0x715b4670ddf00595}, fpelt{0xce763a16ae8b36bd, 0x62459cbcca98cf37}},
+			fp2elt{fpelt{0xfd75bdce55677b22, 0x674b7024dc7749c0}, fpelt{0x3046cb999a2914c2, 0x1575773113e227a9}},
+			fp2elt{fpelt{0xeb6d9049b10161c6, 0x7ef0363129ade032}, fpelt{0x32d451143027d900, 0x34d4651a9a901513}},
+			fp2elt{fpelt{0x71e3073b742db95e, 0x1b86143660c00ed2}, fpelt{0x06c3ff1e0246454d, 0x12f22525d73df412}},
+		},
+		{
+			fp2elt{fpelt{0x18873ffd2e1d73ac, 0x6eac5e1a81e9ae90}, fpelt{0x25246c6c429b7938, 0x0cde5bdbe0e0df68}},
+			fp2elt{fpelt{0x5bc857d85bb6bbe3, 0x445e43349f0ddd42}, fpelt{0x830ab0326d99323a, 0x72684e9ce2975a5b}},
+			fp2elt{fpelt{0x31b5201048ee3f9b, 0x4515ddadbb4d4df0}, fpelt{0x6974a55ebf105090, 0x59c8f3ce58a65b33}},
+			fp2elt{fpelt{0xc3cbef9d50eb011d, 0x0a68110d979e998d}, fpelt{0x45c03705f0f133b0, 0x52b56eaf280fcc94}},
+		},
+		{
+			fp2elt{fpelt{0xe033a2f669895c4d, 0x79a9481de6969637}, fpelt{0xa2d270dc195e7862, 0x779db6c9574bd003}},
+			fp2elt{fpelt{0x6ef1e44d994b2094, 0x6cb6d5d1aa04f556}, fpelt{0xacd6a534d65378b1, 0x5b0f921565053528}},
+			fp2elt{fpelt{0x0dc221df188ecc2a, 0x1aca6c4ce7d3e12c}, fpelt{0xebca47c024ae1934, 0x54c1444d8c959591}},
+			fp2elt{fpelt{0x004d75329c2025fc, 0x775219c960c5f1df}, fpelt{0xec2f49bc7a552df6, 0x2178ef0e0185df5f}},
+		},
+		{
+			fp2elt{fpelt{0x684a52797c47abeb, 0x3189fd67bceda1ea}, fpelt{0x66ac398454bf4f88, 0x39e7b6eef8b684b2}},
+			fp2elt{fpelt{0x5549604886a24cbe, 0x01a38fb97f710964}, fpelt{0xb9ec2f38f148b896, 0x3bd7fccf6be282f1}},
+			fp2elt{fpelt{0x51535a443b5ade11, 0x4984019483fe4e4e}, fpelt{0xb4add161d74c1cda, 0x30cc904bcda68bc6}},
+			fp2elt{fpelt{0x2d986f1e01e5c7a9, 0x6270bd9bf2b51bc7}, fpelt{0xbd03d3b9ae73d2bb, 0x75f01f3ee1632cb0}},
+		},
+		{
+			fp2elt{fpelt{0x30ac7bb7f58e1940, 0x776c31ff15a76e9a}, fpelt{0xd363f209acf23fb5, 0x7bd6df82653c1512}},
+			fp2elt{fpelt{0x4719719b5b884eb0, 0x7ad8b55cc772aa14}, fpelt{0x98b91e7417d792da, 0x45e7b2a9d397b2fc}},
+			fp2elt{fpelt{0xfdf578e9ca3a39ab, 0x1e19e957082040ec}, fpelt{0x5e374af77666949c, 0x4c1c4b239ea9e0c0}},
+			fp2elt{fpelt{0xf156b7bcec1d116b, 0x5fd29845064edef4}, fpelt{0x53d0d5b8a59fc374, 0x32cb67065e6f0fb3}},
+		},
+		{
+			fp2elt{fpelt{0xad400524da899ab0, 0x656f6ecf26a0794f}, fpelt{0x304e00c73608aae1, 0x1a7f59d0733d04d6}},
+			fp2elt{fpelt{0x3b418efa3472129f, 0x26c8661b666a23d9}, fpelt{0xe11a6a83d16b9f15, 0x181643c0c525d732}},
+			fp2elt{fpelt{0xb6057d0d11b34f19, 0x19dbdb8a6b942e3c}, fpelt{0x8a239fd6f326bb41, 0x231134a00e4997e2}},
+			fp2elt{fpelt{0xad62d1480ab6a7f2, 0x6e1c3dfeafc1d1c3}, fpelt{0xfa04ee28227578ee, 0x6f8f66356886bb32}},
+		},
+		{
+			fp2elt{fpelt{0x6919fc2be08eab0d, 0x1e09715d0ae2321a}, fpelt{0xf3f0cfcc36b7a391, 0x67f9809944cf19a0}},
+			fp2elt{fpelt{0xd5637c4f49f3f297, 0x692082804d236e87}, fpelt{0x6115681d575c9588, 0x434daec51820db90}},
+			fp2elt{fpelt{0x42233c94c6436a49, 0x30a2229c0f6c47a2}, fpelt{0x78d2799e0a9ff147, 0x250bf008cb595117}},
+			fp2elt{fpelt{0x715e7e0ec6937d44, 0x7082746eb24a9ef3}, fpelt{0x2f39324438f8a543, 0x1325d1a2dee2c128}},
+		},
+		{
+			fp2elt{fpelt{0x6903e9da77df3cda, 0x65214d29b1d696ec}, fpelt{0x30dff705ded55ca0, 0x22a5e244f92993b1}},
+			fp2elt{fpelt{0x0e3830fe16741127, 0x6a1a63560f706ed9}, fpelt{0xed700ae4c05034cf, 0x39d4c1cceeac1211}},
+			fp2elt{fpelt{0x923890173f8f62d4, 0x27e229f5c23db225}, fpelt{0x3f94c00eb0776262, 0x108eb7a989feb2aa}},
+			fp2elt{fpelt{0xebddfb8be7e1fd72, 0x1834361308393aaa}, fpelt{0xf001aa0327edabbb, 0x3c4a693c0e975030}},
+		},
+		{
+			fp2elt{fpelt{0x4c0bf3909c9c7681, 0x3d3f15ed26ed441c}, fpelt{0x32c4ae116d08cbbf, 0x30a56101e4031967}},
+			fp2elt{fpelt{0xa5dc8cbce375882b, 0x76faf16001fc39bc}, fpelt{0x920d888b47ee471f, 0x6686f92d5612c2a7}},
+			fp2elt{fpelt{0xffabc9dc72965975, 0x72de5cfd4372c4ed}, fpelt{0x4fa85774b5a19bf0, 0x3ef37dabd4fcb2be}},
+			fp2elt{fpelt{0xd95c56a8ef14d647, 0x7eb030c2eb2d9627}, fpelt{0x46d667a3a2fb8fd5, 0x2367ee243d17196c}},
+		},
+		{
+			fp2elt{fpelt{0x7a128ba8bf01aec2, 0x41ecb507b71328d8}, fpelt{0x3390a6ad1188fa0b, 0x76841da3ba760f68}},
+			fp2elt{fpelt{0x1a05f88210a1b6a2, 0x22fdff6df20f13ba}, fpelt{0xec6a0d9ddb8bb034, 0x1993d4108eba3cc9}},
+			fp2elt{fpelt{0x88db326cc9bb7ec7, 0x6d3fc0fd9b30b7e8}, fpelt{0x72f0f8928e0c3d01, 0x46e839415d9d08ac}},
+			fp2elt{fpelt{0x6ba68f60f20c2684, 0x46e36239bfbeb374}, fpelt{0x83b398b25e1b95fe, 0x4cc3f90639815d76}},
+		},
+		{
+			fp2elt{fpelt{0x682b8b7ddcd0fc22, 0x391492c153069edf}, fpelt{0x31dbf319c255715f, 0x0e537ac7eb0dc1f9}},
+			fp2elt{fpelt{0x08a3a3e4d6b1936d, 0x135e5b175183a4f8}, fpelt{0x9ea5bbabe747992f, 0x4451570561f61c5a}},
+			fp2elt{fpelt{0xe319ccafc1e0f43c, 0x756276422f4d21ab}, fpelt{0x0ee6bd193a5e3625, 0x3970f81f5e0a102c}},
+			fp2elt{fpelt{0xf941b64d89758490, 0x52581e9728112db2}, fpelt{0x37903e5a79b02b3d, 0x653b4be57aae2ab6}},
+		},
+		{
+			fp2elt{fpelt{0xe5ff1d5ff81db763, 0x39c5b540c74820f4}, fpelt{0xb99cc1f299aa7d04, 0x06f30275fac79fb5}},
+			fp2elt{fpelt{0x12ad96e5a5386bf4, 0x667d805d66e22c21}, fpelt{0xd0ef397b7e91612c, 0x7eb09824affbb564}},
+			fp2elt{fpelt{0xf9af966bb24e9c73, 0x53f83d8e79a43914}, fpelt{0xce9b55ec37c7ef4d, 0x06c401805c35fd85}},
+			fp2elt{fpelt{0x27e1fb389aaf0e61, 0x364a4feeb2d8c1f9}, fpelt{0x2c3ab0a341b1ac04, 0x24c3bad104d598d9}},
+		},
+		{
+			fp2elt{fpelt{0xce158f7852308d27, 0x1542de5cd028871e}, fpelt{0x49d5549a58c51ec1, 0x4061361be025f490}},
+			fp2elt{fpelt{0xf65e33c341bc41bd, 0x13465409a3d6e691}, fpelt{0x16be71e4f588789e, 0x6fa90a38f1cb3d61}},
+			fp2elt{fpelt{0xffff01579830a481, 0x07eff8b94ec0d5e7}, fpelt{0xc5b0ae7dbfe60a88, 0x53098c55395c1400}},
+			fp2elt{fpelt{0xd4a1ef57425bf23b, 0x2192959f12179ac5}, fpelt{0xd6928989f3d86768, 0x0b5a4daa7320a415}},
+		},
+		{
+			fp2elt{fpelt{0xad06a3f335104401, 0x2a4d36c813ab68e4}, fpelt{0x8275ba9e31806062, 0x49ba7c55b8391718}},
+			fp2elt{fpelt{0xab62a3d0907b328d, 0x4356b0df476c7eda}, fpelt{0x38a3b14d73d315db, 0x77f2e3ca3d11ed54}},
+			fp2elt{fpelt{0x9944da6fff1cabdf, 0x4289f32cd7e00856}, fpelt{0x0af8179a9ec0e15e, 0x0b71e272ac145879}},
+			fp2elt{fpelt{0xac3e35f729d8009d, 0x72f134fa3619cf36}, fpelt{0xbe26e883d1af5714, 0x2635442a92e38e90}},
+		},
+		{
+			fp2elt{fpelt{0x7146f36a7aee6a76, 0x003759142599df7b}, fpelt{0xf8a4c18cda03ab33, 0x6bb10dd973d1739a}},
+			fp2elt{fpelt{0x862529f09465911b, 0x1e1f514893feffa7}, fpelt{0x9d2e1b8c13612fe0, 0x09d18f7d8515e2a2}},
+			fp2elt{fpelt{0xd13b6dd260d2aba6, 0x0de22e9b78ac28c5}, fpelt{0x4e6d7cc93536e84e, 0x748147bfb3f505bb}},
+			fp2elt{fpelt{0xfafa53d295bc4ae8, 0x07f4ea2dbe4d5644}, fpelt{0x966757417619e701, 0x3ae1dc9f9a083d8c}},
+		},
+		{
+			fp2elt{fpelt{0xad7eb0062c464e16, 0x245e3d5d8498dd74}, fpelt{0x4ce90456d66275a3, 0x4d86b67dedddc024}},
+			fp2elt{fpelt{0xd160886082f2d0da, 0x1cfa38f0ed3bfde5}, fpelt{0xb6aad5d23cc853bc, 0x63e9937684a3dd45}},
+			fp2elt{fpelt{0xd087569b99562f59, 0x0eb158f5af0c101e}, fpelt{0x2761d7e2dc26fd58, 0x450cfe4c114fcae1}},
+			fp2elt{fpelt{0x28de6b2d1b69d14d, 0x3b5545db393de878}, fpelt{0x674de72cf91d31e2, 0x28280ffb08953367}},
+		},
+		{
+			fp2elt{fpelt{0x493b691ec5b8d9b1, 0x16303f5f3a3f6957}, fpelt{0x1356f4165ed37a5d, 0x05757bc0f627f977}},
+			fp2elt{fpelt{0x57ffe135ba47a991, 0x70aa97c0054af054}, fpelt{0xf826b95fd739d8e9, 0x2128534d9fac4e12}},
+			fp2elt{fpelt{0x6ede5e061044f968, 0x5a8de4e6d080f1f1}, fpelt{0x06bfee18d12b0b90, 0x48c36f6f9d698074}},
+			fp2elt{fpelt{0xd22dbf8e24e1dd5d, 0x5329cb1c756bd561}, fpelt{0x36155ada65f3c8b2, 0x10387b9fcd7c0895}},
+		},
+		{
+			fp2elt{fpelt{0x369535e9c6622070, 0x09ae95b57882b0e9}, fpelt{0x3d6fa8ba375340fc, 0x1a0a282abf19bf06}},
+			fp2elt{fpelt{0x854e36df715765af, 0x4e79fbb6e36d37b3}, fpelt{0x377fb811cdaf5758, 0x19f45c03fded6855}},
+			fp2elt{fpelt{0x7339263cf4248cb3, 0x72cb317ed0916da0}, fpelt{0xd02d9939c88b8c10, 0x38c8d869171e71ba}},
+			fp2elt{fpelt{0x231ca972ab9e5c56, 0x5f9c5908f4058d79}, fpelt{0x8bf6f3b5b6087b50, 0x4fe8ca03362e8b12}},
+		},
+		{
+			fp2elt{fpelt{0x0194e643818d5e4e, 0x04dbd4e6fa611a6b}, fpelt{0x20a46a95055537a6, 0x1a76f4c641b590a3}},
+			fp2elt{fpelt{0xfdde51128abc541e, 0x1bcff14bc2762cb6}, fpelt{0x256d781f37334496, 0x62e0f9e375e3d694}},
+			fp2elt{fpelt{0x3edbdd61e5307233, 0x4d0ffc442bffda1c}, fpelt{0xdfbbc55cda293cc3, 0x54978a8e0edecbd1}},
+			fp2elt{fpelt{0xaf194e324e8b4d2e, 0x15740952890f7dcb}, fpelt{0x970f11c23736e007, 0x64f43a18f6f5b147}},
+		},
+		{
+			fp2elt{fpelt{0xc160d18ca3f723ff, 0x4f5d6d616c7b434b}, fpelt{0xe6672b44b90004ce, 0x69445775df25c7d6}},
+			fp2elt{fpelt{0x5f4b3d4f9178df07, 0x1e54773052cb73a8}, fpelt{0x8e8b05d38ae46755, 0x204d73afc1788d9e}},
+			fp2elt{fpelt{0x3e6678eed72dde84, 0x639aca50c2594b24}, fpelt{0xcfa5faf2777ede74, 0x7fe29c7e2777c12a}},
+			fp2elt{fpelt{0x4eeb07956aa0bb08, 0x0381440838c17f3b}, fpelt{0xcafb70c0ae8dc9da, 0x5e0a5af8ba877804}},
+		},
+		{
+			fp2elt{fpelt{0x3d395a09b9a3e2b5, 0x5f99dc8d1ab67947}, fpelt{0x64d479a757ef9091, 0x05acc2f8bafcedbd}},
+			fp2elt{fpelt{0xc083f4616c8c34cc, 0x0489fe434de35264}, fpelt{0x8743498871195b43, 0x27373f6f57f9f744}},
+			fp2elt{fpelt{0x5d9459de28f375d1, 0x48bcb36e19fb6143}, fpelt{0x81a7c43307dcea8a, 0x0283afc4bef8ab6d}},
+			fp2elt{fpelt{0xd2fac2f77b8c4b8d, 0x45413651e99edc59}, fpelt{0x0c9f04651f386f72, 0x788c8e311638b92f}},
+		},
+		{
+			fp2elt{fpelt{0x60fe115a27e91fba, 0x777cb5b1f56d00dc}, fpelt{0x01ac6deec312b792, 0x1bcd8b071ed63275}},
+			fp2elt{fpelt{0x3627612df1a03ce6, 0x0ffcf66c16f18950}, fpelt{0xb6de97c18860b979, 0x3d6194e9a9b23fce}},
+			fp2elt{fpelt{0x9e915c8c405eaa41, 0x3d32dffba61a9eb8}, fpelt{0x6fd8608ca0c10ae7, 0x308a446a478c614a}},
+			fp2elt{fpelt{0x340ed3d0776ac121, 0x1b882c50f2fdd337}, fpelt{0x77cc91df97007d73, 0x539f3ae81c53c568}},
+		},
+		{
+			fp2elt{fpelt{0x7b71b90e189e0e35, 0x345b70590c075d15}, fpelt{0x9d2197788759afc2, 0x3167f079f454e388}},
+			fp2elt{fpelt{0x84f11db7be0dd2e3, 0x67f0b8a6205878dd}, fpelt{0x24602de0a5707138, 0x5c8ef00bb9cd090c}},
+			fp2elt{fpelt{0x9f18939ee9dceb40, 0x40f6fa235f5789c1}, fpelt{0x63a0ca497c90e4f7, 0x1d948cdf8e997d9c}},
+			fp2elt{fpelt{0x9069a70895844edf, 0x0ba7057f82d8d869}, fpelt{0xc9aa700d82b51a4a, 0x73e2c510c948c806}},
+		},
+		{
+			fp2elt{fpelt{0xb22b50fae5212208, 0x7b013fc5e7054152}, fpelt{0x2b05ba1dd2067ee9, 0x51e8612e51c600f2}},
+			fp2elt{fpelt{0x106ce59216d966ec, 0x07d23e13c112b358}, fpelt{0x2506307e1e4cb55c, 0x3e55d5188088363e}},
+			fp2elt{fpelt{0xb800a515dc62273b, 0x7b920e0891eb192c}, fpelt{0x66649340a1ba8a49, 0x13ca50b79be6e3f3}},
+			fp2elt{fpelt{0x698b160237acf268, 0x54df7ba557bd3baa}, fpelt{0x7689bec5ccb7485b, 0x0e0be11456761874}},
+		},
+		{
+			fp2elt{fpelt{0x6e908738389a5195, 0x31ee0bf04369b1cd}, fpelt{0xe0f2ee5be3d8e9a6, 0x4dd91b768940a06e}},
+			fp2elt{fpelt{0xe04b2ff85bf0c46b, 0x21fc4e5b9e663744}, fpelt{0xf26776de0d450956, 0x5c213cc9a69934cc}},
+			fp2elt{fpelt{0x0afae281d10b9bc9, 0x18ce2842ab78a874}, fpelt{0x07abd8382579b790, 0x6835c8d1604bcd1a}},
+			fp2elt{fpelt{0x953c008bdb78a29a, 0x22f4c4673d4bb559}, fpelt{0xe22c3bb84ef3de15, 0x5c8a5a9296641481}},
+		},
+	}
+
+	basePointTableEndo = []r2{
+		{
+			fp2elt{fpelt{0x926920a322ad0f08, 0x2928fafa771259b6}, fpelt{0x7f568d6d7abb70cb, 0x7fdffe421e06467f}},
+			fp2elt{fpelt{0xcb0213c6a2e9d7a6, 0x1551d0225773f370}, fpelt{0xab3a94630bcf7a76, 0x771f9ac2811343f5}},
+			fp2elt{fpelt{0x75c6385a8edee1c4, 0x2cdffb072ef1548d}, fpelt{0xb5681cdee9d8157b, 0x53e34cfe9f32a903}},
+			fp2elt{fpelt{0x780d4e280c9e6e86, 0x23eea1558efcf058}, fpelt{0x61e3943de724384a, 0x5ca456b4ec78e882}},
+		},
+		{
+			fp2elt{fpelt{0x10699f5c9cd754f2, 0x2977bdff47ec36c2}, fpelt{0x5c6442fe6599f573, 0x206ee9606a1a0dbf}},
+			fp2elt{fpelt{0xe99528ace54bfc69, 0x04b6cae12dd84b08}, fpelt{0x98a13a90fbd3a0df, 0x5b2fb429ca8e5336}},
+			fp2elt{fpelt{0xbdd23e0982dbc981, 0x159c94ce03cd3f9b}, fpelt{0xe5ade29b635c3f94, 0x6cf39feede1436f4}},
+			fp2elt{fpelt{0x44e1721424d5f350, 0x5b949d642717f3d7}, fpelt{0x1832c111df2b0520, 0x0a6b053bded0d224}},
+		},
+		{
+			fp2elt{fpelt{0xeb0d9a0a9eff3973, 0x53214825a75fc445}, fpelt{0xd210925f9f98a673, 0x6087cdff6908057b}},
+			fp2elt{fpelt{0xf638992f1c2de020, 0x398a92d88add0807}, fpelt{0xd91f1b7e6ff4f463, 0x6497ad2f377c76e1}},
+			fp2elt{fpelt{0xc96c03b74c0e2a27, 0x5069b5c52c42561d}, fpelt{0x0390afefa9708e82, 0x3aaa68dfb105c07e}},
+			fp2elt{fpelt{0x1f7c4729f4f2e69b, 0x04e4fc10e70d584c}, fpelt{0x5779339140ae325d, 0x0a52fcebd26251ba}},
+		},
+		{
+			fp2elt{fpelt{0xcf8b1bef4a40c193, 0x71e97e9f1dd2fd20}, fpelt{0x30d334c41eeb2af9, 0x14263efb570c57a9}},
+			fp2elt{fpelt{0xa807da4c07db2c94, 0x301fc197905f1fb6}, fpelt{0xb2cbaf3541d36f1a, 0x66cb1105a0604325}},
+			fp2elt{fpelt{0xb5c717ac254e049d, 0x397581310c7f05f6}, fpelt{0x2f5f29567c6abed9, 0x54b1e156e6dff3eb}},
+			fp2elt{fpelt{0x2d82a6b753fc4f6c, 0x3205411cca1e85ee}, fpelt{0xeec1d1716ee8bd4d, 0x4de17b40cd21c47b}},
+		},
+		{
+			fp2elt{fpelt{0xfaba49add9a621a9, 0x33e54f126339c252}, fpelt{0xc7dc2f47670b10f4, 0x116882aae9da8106}},
+			fp2elt{fpelt{0xf5dc6ba13736390c, 0x332233a2c4cf65be}, fpelt{0xe76ca88659d89f30, 0x1392760c5999dbee}},
+			fp2elt{fpelt{0xf85390666db24d3b, 0x478af0fc12fe4ad8}, fpelt{0xcd539cdd4dd97f58, 0x0f0dbfef0774be29}},
+			fp2elt{fpelt{0x96510f1723b3d81b, 0x7975db6fbd49f528}, fpelt{0xa48543fb47d0b27c, 0x0379723f89e9d5db}},
+		},
+		{
+			fp2elt{fpelt{0x43b16b308c60e577, 0x172ff5786f482f84}, fpelt{0xf3a62a76bf352340, 0x198327c63be5aee5}},
+			fp2elt{fpelt{0xeab21528a4677b6a, 0x010fdee4e43efcca}, fpelt{0x97f3e92e56fb31ac, 0x11d558e7fe2cee91}},
+			fp2elt{fpelt{0x4699efc42f61cb3b, 0x78e6505f0729fef0}, fpelt{0xd826438b6b61e695, 0x140e78a4826e1ce4}},
+			fp2elt{fpelt{0xbc7ad807b92301fc, 0x04fe6895bbdf5513}, fpelt{0x2e2961dcaf4a74a1, 0x7e2bd3d6593bcfb8}},
+		},
+		{
+			fp2elt{fpelt{0x7c1be6db8de02b86, 0x759308fdf120c35e}, fpelt{0x3e6d76e00986679e, 0x2afcd60a5a0f92d9}},
+			fp2elt{fpelt{0x53f030e53f58424e, 0x3bdfc6f936d262f1}, fpelt{0x1c7830c854a2a956, 0x5b7ce883d85d8c41}},
+			fp2elt{fpelt{0x65e339f6160151ea, 0x53c7b6a9273c4969}, fpelt{0x640f9e49ced2f5a0, 0x3267b694b1b8b450}},
+			fp2elt{fpelt{0x883bbc9137ad204d, 0x65037c2908f2ea56}, fpelt{0x521e593e97732dc8, 0x145eaed165fc4f46}},
+		},
+		{
+			fp2elt{fpelt{0x05c2368a233932ba, 0x1e2e105c2d52c5df}, fpelt{0xf75321a1b0254edf, 0x6cd804f04bd8a0ee}},
+			fp2elt{fpelt{0xf320d08ffb41c6ab, 0x20a3c22d65301d6b}, fpelt{0x168a7b79a763156e, 0x34493e3550daa654}},
+			fp2elt{fpelt{0x8c84482d8ef2d66d, 0x11fba8698153c297}, fpelt{0x785515ee0d9cf108, 0x4e6cf479ed2d9228}},
+			fp2elt{fpelt{0xd2779fc4280966c8, 0x1b1d9ee8b914a289}, fpelt{0x175373c6568300e2, 0x1cbc736d9729603d}},
+		},
+	}
+
+	basePointTableVarTime = []r2{
+		{
+			fp2elt{fpelt{0x926920a322ad0f08, 0x2928fafa771259b6}, fpelt{0x7f568d6d7abb70cb, 0x7fdffe421e06467f}},
+			fp2elt{fpelt{0xcb0213c6a2e9d7a6, 0x1551d0225773f370}, fpelt{0xab3a94630bcf7a76, 0x771f9ac2811343f5}},
+			fp2elt{fpelt{0x75c6385a8edee1c4, 0x2cdffb072ef1548d}, fpelt{0xb5681cdee9d8157b, 0x53e34cfe9f32a903}},
+			fp2elt{fpelt{0x780d4e280c9e6e86, 0x23eea1558efcf058}, fpelt{0x61e3943de724384a, 0x5ca456b4ec78e882}},
+		},
+		{
+			fp2elt{fpelt{0xd4f9ba78b9193034, 0x34ef5a45d2a1c5e4}, fpelt{0xd2a57d4e9bc654c1, 0x38b832b31ebe0e7d}},
+			fp2elt{fpelt{0x1b07d0d0f2ec367f, 0x530327dc90431cd8}, fpelt{0x3dae1a872f1b5cdf, 0x5b1f4efde26fc644}},
+			fp2elt{fpelt{0xf62cc0eee212bca2, 0x113f63a194c31925}, fpelt{0xf9e13828e3cc42d6, 0x6beeed53bb74892c}},
+			fp2elt{fpelt{0x1f9db02e4f20db5d, 0x60a99efc7203987f}, fpelt{0x3fefbb66eeda0ced, 0x034a15e695472b03}},
+		},
+		{
+			fp2elt{fpelt{0x3743ce3e9e85a174, 0x13d22d1d7b477c8d}, fpelt{0x6058dc7a7265f283, 0x7ae31739ef456ec3}},
+			fp2elt{fpelt{0xc32c72a04019db34, 0x20c45f2ef20c798d}, fpelt{0x91c5355b879ec7e1, 0x610148dedc549c63}},
+			fp2elt{fpelt{0xa469d6758086076b, 0x3d3a74f769e440f4}, fpelt{0x7f80f24eb140d778, 0x484f73507c6a16d8}},
+			fp2elt{fpelt{0x087df9910a3f5ab6, 0x0976ea963f436362}, fpelt{0xa4c7a6fc43f199ca, 0x3daac9cd7e89d44b}},
+		},
+		{
+			fp2elt{fpelt{0x028913ae71ffe27b, 0x627617c27e6520c9}, fpelt{0x55ab6d2da7a76f62, 0x1fa3bed64084964d}},
+			fp2elt{fpelt{0x5299f26e8d3d5594, 0x4bb54789c195b5ff}, fpelt{0xe0b854623e18591b, 0x6f50085307285d8f}},
+			fp2elt{fpelt{0x7263fa1049f5e358, 0x418709871952f57b}, fpelt{0xa7116cba6f2d4967, 0x674acdd83d570a36}},
+			fp2elt{fpelt{0x89b77eadc4c27bf3, 0x3204e851af69cde0}, fpelt{0x48bdbba493ed03a5, 0x2490ad20cef3631b}},
+		},
+		{
+			fp2elt{fpelt{0xbd08c33fa5e6a11c, 0x62ea7ae46c35307b}, fpelt{0x110bbc0bb0c1bbbf, 0x4d4b4090122bb188}},
+			fp2elt{fpelt{0x9278225bf5e88e42, 0x78d0ca8b46dfd49c}, fpelt{0xea6c9a5a853bf61d, 0x3406eecd9a495f05}},
+			fp2elt{fpelt{0x7f41eaabcd2d6238, 0x3a093e27a1986f45}, fpelt{0x0e9992ffe3019cea, 0x410ce3335ce45222}},
+			fp2elt{fpelt{0x2e402feae8562095, 0x093d3503941c70fd}, fpelt{0x0311af84d4de07de, 0x2be5fd81136eb926}},
+		},
+		{
+			fp2elt{fpelt{0xae9d4d9e0a9dda00, 0x4439cb004cc5cf88}, fpelt{0xb4705976b432c5c4, 0x6f7b03eea7572b7d}},
+			fp2elt{fpelt{0x0329bc1adf1b90ea, 0x7d90c3b66d69b628}, fpelt{0x192d89f82d588d3f, 0x2844721c94d528d1}},
+			fp2elt{fpelt{0x1a56e1e87563339e, 0x1a096b95f60e247f}, fpelt{0xc30b7908d5ac1b81, 0x3e455c2c30ce8ec6}},
+			fp2elt{fpelt{0x413d74d10c9895d8, 0x745f4b4f205e2566}, fpelt{0xd55a1d6447b422b4, 0x4bbf21dde9943cc3}},
+		},
+		{
+			fp2elt{fpelt{0xb907d0e38e4099d6, 0x340c1f664a394d41}, fpelt{0xe6b7700a8bc6cb69, 0x3b6ed98cb677b109}},
+			fp2elt{fpelt{0x74dcd19a65a1a4ab, 0x37ce901479a6f9ee}, fpelt{0xd49bd7cb95e277b9, 0x218dfda5fb3d0853}},
+			fp2elt{fpelt{0x54c953302358093f, 0x698f22b5b13fe11d}, fpelt{0xc3c23e4cb43fba5b, 0x45b8b86323cbc58c}},
+			fp2elt{fpelt{0xec6fc115d6d18d37, 0x2336d93f3a21495f}, fpelt{0xf5543b235e7e4a68, 0x17283627ae77ba0f}},
+		},
+		{
+			fp2elt{fpelt{0x9495e38bba6dac19, 0x715b4670ddf00595}, fpelt{0xce763a16ae8b36bd, 0x62459cbcca98cf37}},
+			fp2elt{fpelt{0xfd75bdce55677b22, 0x674b7024dc7749c0}, fpelt{0x3046cb999a2914c2, 0x1575773113e227a9}},
+			fp2elt{fpelt{0xeb6d9049b10161c6, 0x7ef0363129ade032}, fpelt{0x32d451143027d900, 0x34d4651a9a901513}},
+			fp2elt{fpelt{0x71e3073b742db95e, 0x1b86143660c00ed2}, fpelt{0x06c3ff1e0246454d, 0x12f22525d73df412}},
+		},
+		{
+			fp2elt{fpelt{0x18873ffd2e1d73ac, 0x6eac5e1a81e9ae90}, fpelt{0x25246c6c429b7938, 0x0cde5bdbe0e0df68}},
+			fp2elt{fpelt{0x5bc857d85bb6bbe3, 0x445e43349f0ddd42}, fpelt{0x830ab0326d99323a, 0x72684e9ce2975a5b}},
+			fp2elt{fpelt{0x31b5201048ee3f9b, 0x4515ddadbb4d4df0}, fpelt{0x6974a55ebf105090, 0x59c8f3ce58a65b33}},
+			fp2elt{fpelt{0xc3cbef9d50eb011d, 0x0a68110d979e998d}, fpelt{0x45c03705f0f133b0, 0x52b56eaf280fcc94}},
+		},
+		{
+			fp2elt{fpelt{0xe033a2f669895c4d, 0x79a9481de6969637}, fpelt{0xa2d270dc195e7862, 0x779db6c9574bd003}},
+			fp2elt{fpelt{0x6ef1e44d994b2094, 0x6cb6d5d1aa04f556}, fpelt{0xacd6a534d65378b1, 0x5b0f921565053528}},
+			fp2elt{fpelt{0x0dc221df188ecc2a, 0x1aca6c4ce7d3e12c}, fpelt{0xebca47c024ae1934, 0x54c1444d8c959591}},
+			fp2elt{fpelt{0x004d75329c2025fc, 0x775219c960c5f1df}, fpelt{0xec2f49bc7a552df6, 0x2178ef0e0185df5f}},
+		},
+		{
+			fp2elt{fpelt{0x684a52797c47abeb, 0x3189fd67bceda1ea}, fpelt{0x66ac398454bf4f88, 0x39e7b6eef8b684b2}},
+			fp2elt{fpelt{0x5549604886a24cbe, 0x01a38fb97f710964}, fpelt{0xb9ec2f38f148b896, 0x3bd7fccf6be282f1}},
+			fp2elt{fpelt{0x51535a443b5ade11, 0x4984019483fe4e4e}, fpelt{0xb4add161d74c1cda, 0x30cc904bcda68bc6}},
+			fp2elt{fpelt{0x2d986f1e01e5c7a9, 0x6270bd9bf2b51bc7}, fpelt{0xbd03d3b9ae73d2bb, 0x75f01f3ee1632cb0}},
+		},
+		{
+			fp2elt{fpelt{0x30ac7bb7f58e1940, 0x776c31ff15a76e9a}, fpelt{0xd363f209acf23fb5, 0x7bd6df82653c1512}},
+			fp2elt{fpelt{0x4719719b5b884eb0, 0x7ad8b55cc772aa14}, fpelt{0x98b91e7417d792da, 0x45e7b2a9d397b2fc}},
+			fp2elt{fpelt{0xfdf578e9ca3a39ab, 0x1e19e957082040ec}, fpelt{0x5e374af77666949c, 0x4c1c4b239ea9e0c0}},
+			fp2elt{fpelt{0xf156b7bcec1d116b, 0x5fd29845064edef4}, fpelt{0x53d0d5b8a59fc374, 0x32cb67065e6f0fb3}},
+		},
+		{
+			fp2elt{fpelt{0xad400524da899ab0, 0x656f6ecf26a0794f}, fpelt{0x304e00c73608aae1, 0x1a7f59d0733d04d6}},
+			fp2elt{fpelt{0x3b418efa3472129f, 0x26c8661b666a23d9}, fpelt{0xe11a6a83d16b9f15, 0x181643c0c525d732}},
+			fp2elt{fpelt{0xb6057d0d11b34f19, 0x19dbdb8a6b942e3c}, fpelt{0x8a239fd6f326bb41, 0x231134a00e4997e2}},
+			fp2elt{fpelt{0xad62d1480ab6a7f2, 0x6e1c3dfeafc1d1c3}, fpelt{0xfa04ee28227578ee, 0x6f8f66356886bb32}},
+		},
+		{
+			fp2elt{fpelt{0x6919fc2be08eab0d, 0x1e09715d0ae2321a}, fpelt{0xf3f0cfcc36b7a391, 0x67f9809944cf19a0}},
+			fp2elt{fpelt{0xd5637c4f49f3f297, 0x692082804d236e87}, fpelt{0x6115681d575c9588, 0x434daec51820db90}},
+			fp2elt{fpelt{0x42233c94c6436a49, 0x30a2229c0f6c47a2}, fpelt{0x78d2799e0a9ff147, 0x250bf008cb595117}},
+			fp2elt{fpelt{0x715e7e0ec6937d44, 0x7082746eb24a9ef3}, fpelt{0x2f39324438f8a543, 0x1325d1a2dee2c128}},
+		},
+		{
+			fp2elt{fpelt{0x6903e9da77df3cda, 0x65214d29b1d696ec}, fpelt{0x30dff705ded55ca0, 0x22a5e244f92993b1}},
+			fp2elt{fpelt{0x0e3830fe16741127, 0x6a1a63560f706ed9}, fpelt{0xed700ae4c05034cf, 0x39d4c1cceeac1211}},
+			fp2elt{fpelt{0x923890173f8f62d4, 0x27e229f5c23db225}, fpelt{0x3f94c00eb0776262, 0x108eb7a989feb2aa}},
+			fp2elt{fpelt{0xebddfb8be7e1fd72, 0x1834361308393aaa}, fpelt{0xf001aa0327edabbb, 0x3c4a693c0e975030}},
+		},
+		{
+			fp2elt{fpelt{0x4c0bf3909c9c7681, 0x3d3f15ed26ed441c}, fpelt{0x32c4ae116d08cbbf, 0x30a56101e4031967}},
+			fp2elt{fpelt{0xa5dc8cbce375882b, 0x76faf16001fc39bc}, fpelt{0x920d888b47ee471f, 0x6686f92d5612c2a7}},
+			fp2elt{fpelt{0xffabc9dc72965975, 0x72de5cfd4372c4ed}, fpelt{0x4fa85774b5a19bf0, 0x3ef37dabd4fcb2be}},
+			fp2elt{fpelt{0xd95c56a8ef14d647, 0x7eb030c2eb2d9627}, fpelt{0x46d667a3a2fb8fd5, 0x2367ee243d17196c}},
+		},
+		{
+			fp2elt{fpelt{0x7a128ba8bf01aec2, 0x41ecb507b71328d8}, fpelt{0x3390a6ad1188fa0b, 0x76841da3ba760f68}},
+			fp2elt{fpelt{0x1a05f88210a1b6a2, 0x22fdff6df20f13ba}, fpelt{0xec6a0d9ddb8bb034, 0x1993d4108eba3cc9}},
+			fp2elt{fpelt{0x88db326cc9bb7ec7, 0x6d3fc0fd9b30b7e8}, fpelt{0x72f0f8928e0c3d01, 0x46e839415d9d08ac}},
+			fp2elt{fpelt{0x6ba68f60f20c2684, 0x46e36239bfbeb374}, fpelt{0x83b398b25e1b95fe, 0x4cc3f90639815d76}},
+		},
+		{
+			fp2elt{fpelt{0x682b8b7ddcd0fc22, 0x391492c153069edf}, fpelt{0x31dbf319c255715f, 0x0e537ac7eb0dc1f9}},
+			fp2elt{fpelt{0x08a3a3e4d6b1936d, 0x135e5b175183a4f8}, fpelt{0x9ea5bbabe747992f, 0x4451570561f61c5a}},
+			fp2elt{fpelt{0xe319ccafc1e0f43c, 0x756276422f4d21ab}, fpelt{0x0ee6bd193a5e3625, 0x3970f81f5e0a102c}},
+			fp2elt{fpelt{0xf941b64d89758490, 0x52581e9728112db2}, fpelt{0x37903e5a79b02b3d, 0x653b4be57aae2ab6}},
+		},
+		{
+			fp2elt{fpelt{0xe5ff1d5ff81db763, 0x39c5b540c74820f4}, fpelt{0xb99cc1f299aa7d04, 0x06f30275fac79fb5}},
+			fp2elt{fpelt{0x12ad96e5a5386bf4, 0x667d805d66e22c21}, fpelt{0xd0ef397b7e91612c, 0x7eb09824affbb564}},
+			fp2elt{fpelt{0xf9af966bb24e9c73, 0x53f83d8e79a43914}, fpelt{0xce9b55ec37c7ef4d, 0x06c401805c35fd85}},
+			fp2elt{fpelt{0x27e1fb389aaf0e61, 0x364a4feeb2d8c1f9}, fpelt{0x2c3ab0a341b1ac04, 0x24c3bad104d598d9}},
+		},
+		{
+			fp2elt{fpelt{0xce158f7852308d27, 0x1542de5cd028871e}, fpelt{0x49d5549a58c51ec1, 0x4061361be025f490}},
+			fp2elt{fpelt{0xf65e33c341bc41bd, 0x13465409a3d6e691}, fpelt{0x16be71e4f588789e, 0x6fa90a38f1cb3d61}},
+			fp2elt{fpelt{0xffff01579830a481, 0x07eff8b94ec0d5e7}, fpelt{0xc5b0ae7dbfe60a88, 0x53098c55395c1400}},
+			fp2elt{fpelt{0xd4a1ef57425bf23b, 0x2192959f12179ac5}, fpelt{0xd6928989f3d86768, 0x0b5a4daa7320a415}},
+		},
+		{
+			fp2elt{fpelt{0xad06a3f335104401, 0x2a4d36c813ab68e4}, fpelt{0x8275ba9e31806062, 0x49ba7c55b8391718}},
+			fp2elt{fpelt{0xab62a3d0907b328d, 0x4356b0df476c7eda}, fpelt{0x38a3b14d73d315db, 0x77f2e3ca3d11ed54}},
+			fp2elt{fpelt{0x9944da6fff1cabdf, 0x4289f32cd7e00856}, fpelt{0x0af8179a9ec0e15e, 0x0b71e272ac145879}},
+			fp2elt{fpelt{0xac3e35f729d8009d, 0x72f134fa3619cf36}, fpelt{0xbe26e883d1af5714, 0x2635442a92e38e90}},
+		},
+		{
+			fp2elt{fpelt{0x7146f36a7aee6a76, 0x003759142599df7b}, fpelt{0xf8a4c18cda03ab33, 0x6bb10dd973d1739a}},
+			fp2elt{fpelt{0x862529f09465911b, 0x1e1f514893feffa7}, fpelt{0x9d2e1b8c13612fe0, 0x09d18f7d8515e2a2}},
+			fp2elt{fpelt{0xd13b6dd260d2aba6, 0x0de22e9b78ac28c5}, fpelt{0x4e6d7cc93536e84e, 0x748147bfb3f505bb}},
+			fp2elt{fpelt{0xfafa53d295bc4ae8, 0x07f4ea2dbe4d5644}, fpelt{0x966757417619e701, 0x3ae1dc9f9a083d8c}},
+		},
+		{
+			fp2elt{fpelt{0xad7eb0062c464e16, 0x245e3d5d8498dd74}, fpelt{0x4ce90456d66275a3, 0x4d86b67dedddc024}},
+			fp2elt{fpelt{0xd160886082f2d0da, 0x1cfa38f0ed3bfde5}, fpelt{0xb6aad5d23cc853bc, 0x63e9937684a3dd45}},
+			fp2elt{fpelt{0xd087569b99562f59, 0x0eb158f5af0c101e}, fpelt{0x2761d7e2dc26fd58, 0x450cfe4c114fcae1}},
+			fp2elt{fpelt{0x28de6b2d1b69d14d, 0x3b5545db393de878}, fpelt{0x674de72cf91d31e2, 0x28280ffb08953367}},
+		},
+		{
+			fp2elt{fpelt{0x493b691ec5b8d9b1, 0x16303f5f3a3f6957}, fpelt{0x1356f4165ed37a5d, 0x05757bc0f627f977}},
+			fp2elt{fpelt{0x57ffe135ba47a991, 0x70aa97c0054af054}, fpelt{0xf826b95fd739d8e9, 0x2128534d9fac4e12}},
+			fp2elt{fpelt{0x6ede5e061044f968, 0x5a8de4e6d080f1f1}, fpelt{0x06bfee18d12b0b90, 0x48c36f6f9d698074}},
+			fp2elt{fpelt{0xd22dbf8e24e1dd5d, 0x5329cb1c756bd561}, fpelt{0x36155ada65f3c8b2, 0x10387b9fcd7c0895}},
+		},
+		{
+			fp2elt{fpelt{0x369535e9c6622070, 0x09ae95b57882b0e9}, fpelt{0x3d6fa8ba375340fc, 0x1a0a282abf19bf06}},
+			fp2elt{fpelt{0x854e36df715765af, 0x4e79fbb6e36d37b3}, fpelt{0x377fb811cdaf5758, 0x19f45c03fded6855}},
+			fp2elt{fpelt{0x7339263cf4248cb3, 0x72cb317ed0916da0}, fpelt{0xd02d9939c88b8c10, 0x38c8d869171e71ba}},
+			fp2elt{fpelt{0x231ca972ab9e5c56, 0x5f9c5908f4058d79}, fpelt{0x8bf6f3b5b6087b50, 0x4fe8ca03362e8b12}},
+		},
+		{
+			fp2elt{fpelt{0x0194e643818d5e4e, 0x04dbd4e6fa611a6b}, fpelt{0x20a46a95055537a6, 0x1a76f4c641b590a3}},
+			fp2elt{fpelt{0xfdde51128abc541e, 0x1bcff14bc2762cb6}, fpelt{0x256d781f37334496, 0x62e0f9e375e3d694}},
+			fp2elt{fpelt{0x3edbdd61e5307233, 0x4d0ffc442bffda1c}, fpelt{0xdfbbc55cda293cc3, 0x54978a8e0edecbd1}},
+			fp2elt{fpelt{0xaf194e324e8b4d2e, 0x15740952890f7dcb}, fpelt{0x970f11c23736e007, 0x64f43a18f6f5b147}},
+		},
+		{
+			fp2elt{fpelt{0xc160d18ca3f723ff, 0x4f5d6d616c7b434b}, fpelt{0xe6672b44b90004ce, 0x69445775df25c7d6}},
+			fp2elt{fpelt{0x5f4b3d4f9178df07, 0x1e54773052cb73a8}, fpelt{0x8e8b05d38ae46755, 0x204d73afc1788d9e}},
+			fp2elt{fpelt{0x3e6678eed72dde84, 0x639aca50c2594b24}, fpelt{0xcfa5faf2777ede74, 0x7fe29c7e2777c12a}},
+			fp2elt{fpelt{0x4eeb07956aa0bb08, 0x0381440838c17f3b}, fpelt{0xcafb70c0ae8dc9da, 0x5e0a5af8ba877804}},
+		},
+		{
+			fp2elt{fpelt{0x3d395a09b9a3e2b5, 0x5f99dc8d1ab67947}, fpelt{0x64d479a757ef9091, 0x05acc2f8bafcedbd}},
+			fp2elt{fpelt{0xc083f4616c8c34cc, 0x0489fe434de35264}, fpelt{0x8743498871195b43, 0x27373f6f57f9f744}},
+			fp2elt{fpelt{0x5d9459de28f375d1, 0x48bcb36e19fb6143}, fpelt{0x81a7c43307dcea8a, 0x0283afc4bef8ab6d}},
+			fp2elt{fpelt{0xd2fac2f77b8c4b8d, 0x45413651e99edc59}, fpelt{0x0c9f04651f386f72, 0x788c8e311638b92f}},
+		},
+		{
+			fp2elt{fpelt{0x60fe115a27e91fba, 0x777cb5b1f56d00dc}, fpelt{0x01ac6deec312b792, 0x1bcd8b071ed63275}},
+			fp2elt{fpelt{0x3627612df1a03ce6, 0x0ffcf66c16f18950}, fpelt{0xb6de97c18860b979, 0x3d6194e9a9b23fce}},
+			fp2elt{fpelt{0x9e915c8c405eaa41, 0x3d32dffba61a9eb8}, fpelt{0x6fd8608ca0c10ae7, 0x308a446a478c614a}},
+			fp2elt{fpelt{0x340ed3d0776ac121, 0x1b882c50f2fdd337}, fpelt{0x77cc91df97007d73, 0x539f3ae81c53c568}},
+		},
+		{
+			fp2elt{fpelt{0x7b71b90e189e0e35, 0x345b70590c075d15}, fpelt{0x9d2197788759afc2, 0x3167f079f454e388}},
+			fp2elt{fpelt{0x84f11db7be0dd2e3, 0x67f0b8a6205878dd}, fpelt{0x24602de0a5707138, 0x5c8ef00bb9cd090c}},
+			fp2elt{fpelt{0x9f18939ee9dceb40, 0x40f6fa235f5789c1}, fpelt{0x63a0ca497c90e4f7, 0x1d948cdf8e997d9c}},
+			fp2elt{fpelt{0x9069a70895844edf, 0x0ba7057f82d8d869}, fpelt{0xc9aa700d82b51a4a, 0x73e2c510c948c806}},
+		},
+		{
+			fp2elt{fpelt{0xb22b50fae5212208, 0x7b013fc5e7054152}, fpelt{0x2b05ba1dd2067ee9, 0x51e8612e51c600f2}},
+			fp2elt{fpelt{0x106ce59216d966ec, 0x07d23e13c112b358}, fpelt{0x2506307e1e4cb55c, 0x3e55d5188088363e}},
+			fp2elt{fpelt{0xb800a515dc62273b, 0x7b920e0891eb192c}, fpelt{0x66649340a1ba8a49, 0x13ca50b79be6e3f3}},
+			fp2elt{fpelt{0x698b160237acf268, 0x54df7ba557bd3baa}, fpelt{0x7689bec5ccb7485b, 0x0e0be11456761874}},
+		},
+		{
+			fp2elt{fpelt{0x6e908738389a5195, 0x31ee0bf04369b1cd}, fpelt{0xe0f2ee5be3d8e9a6, 0x4dd91b768940a06e}},
+			fp2elt{fpelt{0xe04b2ff85bf0c46b, 0x21fc4e5b9e663744}, fpelt{0xf26776de0d450956, 0x5c213cc9a69934cc}},
+			fp2elt{fpelt{0x0afae281d10b9bc9, 0x18ce2842ab78a874}, fpelt{0x07abd8382579b790, 0x6835c8d1604bcd1a}},
+			fp2elt{fpelt{0x953c008bdb78a29a, 0x22f4c4673d4bb559}, fpelt{0xe22c3bb84ef3de15, 0x5c8a5a9296641481}},
+		},
+		{
+			fp2elt{fpelt{0x6839e867850c8653, 0x1962b525ca4aabb8}, fpelt{0x2d8a25e10a3ffb38, 0x343c1fac5a7132c4}},
+			fp2elt{fpelt{0xa469cd758e856509, 0x479c63b3c3752442}, fpelt{0x1c469e99d80c7a3e, 0x5510bc38dc0eb625}},
+			fp2elt{fpelt{0x3f1d44dac0655328, 0x575522e9bae996e6}, fpelt{0xe00a5faa02c49df9, 0x5cb9a6a578787b38}},
+			fp2elt{fpelt{0xda770eebc45a365e, 0x2be08b572819962f}, fpelt{0x5791ad02b45a1c2a, 0x1594fc49725353db}},
+		},
+		{
+			fp2elt{fpelt{0xc7386c1a036aaa3a, 0x0261545d3170c086}, fpelt{0x7976507c9bfe2080, 0x66af92fb6634fdc0}},
+			fp2elt{fpelt{0xdbb03e64281abf67, 0x16deb233081267a3}, fpelt{0x9d7ff6e51ce0a8ef, 0x4ab32653a2971931}},
+			fp2elt{fpelt{0x4e53f38fade10ef4, 0x4f904a0fa72c261a}, fpelt{0xb093b02a14875418, 0x22511c2ffe2fab63}},
+			fp2elt{fpelt{0x4045afd05eea883a, 0x2a8b7bcfb5d8ac89}, fpelt{0x9fbb3a6f8a346e76, 0x6e24a8899074a26d}},
+		},
+		{
+			fp2elt{fpelt{0xc8925b0c8cd53275, 0x407165966c84e201}, fpelt{0x01bbc33e757b31ae, 0x1d0f62bdb9f750fc}},
+			fp2elt{fpelt{0xb9c2f8f602552aee, 0x7c9d8c1219e73f13}, fpelt{0x910cd237fd12df21, 0x2fa35af5e3f1a0c1}},
+			fp2elt{fpelt{0xadbbdf2623839449, 0x06a7fa26c494e13e}, fpelt{0x2111c5e4dcbb2d45, 0x06c6bf7ec09d2d44}},
+			fp2elt{fpelt{0xa50311f811310f58, 0x41ed37aaec9f3c4f}, fpelt{0x6feb29abddee6b0d, 0x4cb5c74eb58eb038}},
+		},
+		{
+			fp2elt{fpelt{0x28abec50c02b6fa4, 0x6e63513583b7c50e}, fpelt{0xd4464deb0b671ed2, 0x3453469e2b5ed5fd}},
+			fp2elt{fpelt{0x15caf809058758c1, 0x50bec0500388b701}, fpelt{0xa84a7d6203b986ca, 0x62aa2991c15573e2}},
+			fp2elt{fpelt{0x2c2af285df189377, 0x79b57172a6e676fb}, fpelt{0x0692ce57d565c545, 0x36492a625566bb82}},
+			fp2elt{fpelt{0x28f6f34670c29944, 0x227fd256bb9e7cbb}, fpelt{0x2cb6bebf1d2fbfc5, 0x6f57db08a875544b}},
+		},
+		{
+			fp2elt{fpelt{0xcfee3daf0f5855f8, 0x7360b6f84df8b0ad}, fpelt{0xc3dacbc3e2a09612, 0x1ea546b8beb5eeb2}},
+			fp2elt{fpelt{0x2ee197eb4023560a, 0x4c98bf5e2ceaa21f}, fpelt{0x547ab54df9fc9dc5, 0x46cab824cf7b8d7c}},
+			fp2elt{fpelt{0x8642752b8d1f3123, 0x1e87a5730d9b9afe}, fpelt{0x5d5df2285bdbed6e, 0x5d277b2995242829}},
+			fp2elt{fpelt{0xfcfc54ff9b83edf4, 0x61485297c6a15b5d}, fpelt{0xd48a4015469bbf13, 0x0c2d2c116c5b8d18}},
+		},
+		{
+			fp2elt{fpelt{0x96b2d553a8124e68, 0x609c88af789808b6}, fpelt{0x1fa751943fe652f0, 0x7746584bd796a710}},
+			fp2elt{fpelt{0xd7c3c6b1ac644bf9, 0x4afb383504a39b99}, fpelt{0x9bf19a24b0ed6558, 0x5ef96c614ca81f40}},
+			fp2elt{fpelt{0x1ff7afeb7f115ce2, 0x4a9da85f817e4d2a}, fpelt{0xa9a773eb70452e84, 0x1266a02e3931cf84}},
+			fp2elt{fpelt{0x1fd09d2bed4199e2, 0x08c3ba579f777ed2}, fpelt{0xed533cdcc3288c59, 0x1cb97a1dca19c7a9}},
+		},
+		{
+			fp2elt{fpelt{0x1e6bce555ba86afd, 0x2cefe9ec564dcd69}, fpelt{0xf05ace38034af297, 0x4b60cba44caad666}},
+			fp2elt{fpelt{0x30c47adc5585ff24, 0x0872ee1a297aa2ef}, fpelt{0x96ebc57e9d7fd549, 0x6056b8c05b52cbde}},
+			fp2elt{fpelt{0x80265fee2eb15b4d, 0x7c3ab272599bf4a8}, fpelt{0x6aa49f41a9ad6998, 0x2d6ea43fca2fddcf}},
+			fp2elt{fpelt{0xa09eb6e82c8c4ca2, 0x31434f8b10195b86}, fpelt{0xe6c761debf35dd02, 0x7c60b035dbcb9d5c}},
+		},
+		{
+			fp2elt{fpelt{0x324962946c1d39a0, 0x25cc4eea5b3139b6}, fpelt{0xe3f5f8b58a628747, 0x080ea07d3ae56bfb}},
+			fp2elt{fpelt{0x1eff7b3400458c2e, 0x41081061bb87668e}, fpelt{0x079f6cce58a723f4, 0x7ff90f95b9497bbe}},
+			fp2elt{fpelt{0x65a65117c6ab9458, 0x5fe0d7a8784b2ef6}, fpelt{0xd73932f3e2c0ed79, 0x60b610410e1dbd90}},
+			fp2elt{fpelt{0x99a39992fd0fe08e, 0x15d140b5cd33c232}, fpelt{0x931e5fcb0495612b, 0x1595f7078090059b}},
+		},
+		{
+			fp2elt{fpelt{0x11169e7967e3082e, 0x72f3be9dae8201d8}, fpelt{0x9a816f341a7e369c, 0x26a3a2bf1900747f}},
+			fp2elt{fpelt{0xd4f2202b28096878, 0x3e1db0defc54c52d}, fpelt{0x96b0628e81773eba, 0x76a327e2af31bf26}},
+			fp2elt{fpelt{0x20a5c6fb1d488009, 0x32c4d41b1c1c0440}, fpelt{0x32ee409781378513, 0x6349ec0db3b86c39}},
+			fp2elt{fpelt{0x76be4ad7d9e2a2ad, 0x4c2d6ae0de80d15d}, fpelt{0x60510604bbd68e44, 0x721dbf8c1a53285f}},
+		},
+		{
+			fp2elt{fpelt{0x0ffc2b0d7f8e7d12, 0x178a1426d22a366f}, fpelt{0x5d7b2fd21a3e14d7, 0x7624135f256a0f61}},
+			fp2elt{fpelt{0xd0ccc0399399544c, 0x24aecdc2beaf708a}, fpelt{0xb89dab6fddc3d7cb, 0x5d68298b16cd17ad}},
+			fp2elt{fpelt{0x9c0fdbd29b7b7f43, 0x0c5ec0a8319eddd8}, fpelt{0x4574c6542a82239f, 0x1e00d6b4c48cddcb}},
+			fp2elt{fpelt{0xc335330cbfe52b0b, 0x7c03767f096d4405}, fpelt{0x330ae6dea8c843c1, 0x5f3771d29b4b29e6}},
+		},
+		{
+			fp2elt{fpelt{0x35370e3253c44279, 0x1a389caeecdebaeb}, fpelt{0x8cc5a8f2c3befc81, 0x67c7bb1d72f5a4be}},
+			fp2elt{fpelt{0xc59d0dc2f98cf0cc, 0x48f1c0d9c39fc4b7}, fpelt{0xe948c01540e7257a, 0x065feee1b6b56a52}},
+			fp2elt{fpelt{0xa1ba913b95e69454, 0x7dc8083ec9a29ad7}, fpelt{0x1f7d7fa8e91dbfb9, 0x2fff471753174d29}},
+			fp2elt{fpelt{0x594d908de9c66ec6, 0x2f2165ff1055c1e1}, fpelt{0xdde53896bae5bfb0, 0x782e8b6c606acabc}},
+		},
+		{
+			fp2elt{fpelt{0xec8e6706e54fb25c, 0x71dd7aefd97cd764}, fpelt{0x9adda10eb228afdc, 0x7bfc8bb7e4ec7480}},
+			fp2elt{fpelt{0x69c6d1c94f75db7c, 0x5eda5557e9631241}, fpelt{0x17feda58df993b82, 0x2c869017f2166faa}},
+			fp2elt{fpelt{0xd1a5b5c5582b7a37, 0x1989de5e53ae18cb}, fpelt{0x9a158194f3d131cc, 0x6eb287a376354b99}},
+			fp2elt{fpelt{0x160647d31a68fd38, 0x49a71dab89a29c0f}, fpelt{0x9ec6e6a59a8450fa, 0x3b769b4e19737ef3}},
+		},
+		{
+			fp2elt{fpelt{0xd4950002b1b9b856, 0x148119044b1af681}, fpelt{0xc79b6a3650274033, 0x357b1dc814b6e66f}},
+			fp2elt{fpelt{0x15e562c1ed799d7e, 0x11216ef58489208a}, fpelt{0xa198d2b4a0e3a0b2, 0x3864f5b32ed09232}},
+			fp2elt{fpelt{0x11777a30400157c3, 0x7708c13e41986292}, fpelt{0xc9f90d8c2c2c009f, 0x1281d437ae6e7fff}},
+			fp2elt{fpelt{0xc9bd4a935d945dc1, 0x5cf6a99b336833f8}, fpelt{0xc2e863f8bbe4d732, 0x3d1757354ac7c443}},
+		},
+		{
+			fp2elt{fpelt{0x3b0582037ec73458, 0x4406c396e9ec38f2}, fpelt{0xbc731decc64c4090, 0x0a7f80aa37424ddc}},
+			fp2elt{fpelt{0x0edcade9fc75cd7c, 0x6a3ca5d97446d183}, fpelt{0x21bf660e4e5d23ea, 0x43ec5fdce456aa5e}},
+			fp2elt{fpelt{0xba0757acd99bbfde, 0x43286a365fae2be8}, fpelt{0x4543af6593b1a662, 0x46e36ed866335760}},
+			fp2elt{fpelt{0x27e87b1965805eef, 0x26f3af290b1e6f28}, fpelt{0xca59cbeb2481b48d, 0x4141997d403ea828}},
+		},
+		{
+			fp2elt{fpelt{0x38a177c38afa4f60, 0x696cd4f12cf2e201}, fpelt{0xa39cc93e36cbd745, 0x2d000d906c4ed37a}},
+			fp2elt{fpelt{0xe87cbe77d08c696c, 0x4e40dcd8156243b1}, fpelt{0xfedd0f893cd0be3a, 0x5c5fd6462821555a}},
+			fp2elt{fpelt{0x3534b7190a152ba4, 0x70f62f48ad563399}, fpelt{0x3d51e8f6cbac3a05, 0x0991a2fccad7fabe}},
+			fp2elt{fpelt{0xa1cb6b1cacd1838f, 0x50ecf7f8797ce9b5}, fpelt{0xa1f3018584992245, 0x29fbb7f1afce37da}},
+		},
+		{
+			fp2elt{fpelt{0xf642280e90c2d321, 0x4f5d7408fb0b9440}, fpelt{0x779206570cbffb31, 0x410c2805bddbc406}},
+			fp2elt{fpelt{0x72c4da8f64f73f0c, 0x5b5f067f12e479b8}, fpelt{0xeb58ae3475378800, 0x2cc5057e9805da46}},
+			fp2elt{fpelt{0x3dad3c458e0c42be, 0x45c907f5e373478a}, fpelt{0x696f2703d90c1720, 0x2333ea4de2412b29}},
+			fp2elt{fpelt{0x26c098dc4de995e7, 0x0be4aa5f6205fe87}, fpelt{0x099913c78eb75c8a, 0x2e4aa7e00ccb2544}},
+		},
+		{
+			fp2elt{fpelt{0x09deeac25513dfbb, 0x5b16770dd4e98522}, fpelt{0xe454d2c20ab5a70b, 0x073589a956b4cee2}},
+			fp2elt{fpelt{0x28cbabc8ab07e0c3, 0x1fd77a5a83c1c845}, fpelt{0x26975058b4a7020b, 0x44344464f1b2b23e}},
+			fp2elt{fpelt{0x5b035cedb09378dd, 0x4cb38912fbf90066}, fpelt{0xfa47c410a7cbb9b1, 0x70220b503cca1f7d}},
+			fp2elt{fpelt{0x6092af784e5a1781, 0x5f7862e5dec76225}, fpelt{0x8127401d91f203ca, 0x0ad0a6cc78c4c358}},
+		},
+		{
+			fp2elt{fpelt{0x7bbccf14edea7dba, 0x4373cc0bb1dcbc98}, fpelt{0xe49349d3b64d1816, 0x78d1df4d61c30920}},
+			fp2elt{fpelt{0x540e86c358128768, 0x599a44ec62106176}, fpelt{0x4b705365bec341e0, 0x56967191f94393b2}},
+			fp2elt{fpelt{0x70c08242acf9f7c2, 0x4c08451fdda303a1}, fpelt{0x0ed447d53ba13115, 0x396c5c6f99f68c5e}},
+			fp2elt{fpelt{0x10d620661107b125, 0x01eb3c966f32bcb7}, fpelt{0x58a8c0c845897f57, 0x3bf2327156efe734}},
+		},
+		{
+			fp2elt{fpelt{0x7a58583c4d607e56, 0x7721773206a9d3b5}, fpelt{0xf869255106558bda, 0x2f8e3420ce88a6b6}},
+			fp2elt{fpelt{0xca3c6dd373c7b669, 0x106f7b37e232f05f}, fpelt{0x172f402b17a20e4a, 0x6e378fd42907c614}},
+			fp2elt{fpelt{0x30a0defdfa50d34e, 0x511d6fdd720596ac}, fpelt{0xf1fe8154f4528488, 0x4589801836143e2b}},
+			fp2elt{fpelt{0xece00549447e1cd5, 0x76d2a221e8739c7c}, fpelt{0x8e94632b6d3d817c, 0x2d21a23cb9f9819c}},
+		},
+		{
+			fp2elt{fpelt{0xee7dca07f31afa90, 0x2795204cf23d6797}, fpelt{0x8037709d44eea543, 0x246969b1054e3423}},
+			fp2elt{fpelt{0x77c23ec561258a95, 0x7af34858661d971d}, fpelt{0xece4c0542cc796f0, 0x23ad4946370ab720}},
+			fp2elt{fpelt{0x081d98fdd5ecf859, 0x7394468932843887}, fpelt{0x33faa89fdac05099, 0x3b284aed4ed3c18e}},
+			fp2elt{fpelt{0xc9cccee41f615967, 0x490bfbc6fd28993c}, fpelt{0xbc7d13fd02e8ae12, 0x625229d758336e05}},
+		},
+		{
+			fp2elt{fpelt{0xc45e8cf3fe9f6a92, 0x5f37276e6bdd6955}, fpelt{0x9ca6e3b74972a860, 0x4d123ec5cef27c10}},
+			fp2elt{fpelt{0xb3a8b144a5de670b, 0x05067e223bcf7cbe}, fpelt{0x23e3559112f7c2b4, 0x435473b581236ee6}},
+			fp2elt{fpelt{0x753c1bc36c559a4e, 0x338408d07325bcb7}, fpelt{0x1c53eca57695319d, 0x5064f8346c392cd6}},
+			fp2elt{fpelt{0xf419cdf3518aebc1, 0x18e6193cdba766d9}, fpelt{0x92cf057d356425d1, 0x565948f3b3ea49c9}},
+		},
+		{
+			fp2elt{fpelt{0x101c944b445bdc97, 0x1cda1cf55a7c4602}, fpelt{0xb9e2347b0a8d47c5, 0x26895f2b6dee6867}},
+			fp2elt{fpelt{0xdd8d17fa00652deb, 0x7c4f3058520c0abe}, fpelt{0xec1eb303543a2c07, 0x38a23eb05bc7af43}},
+			fp2elt{fpelt{0x0ef475f5900ced65, 0x35d7a2148a4b50ce}, fpelt{0xffcc5ff3ebf8e076, 0x7ca1e92bf6fa061d}},
+			fp2elt{fpelt{0x6ec567603b26ee55, 0x13d8a85e8a4b7ead}, fpelt{0x6e15acee3b3bc62d, 0x1cf2d6fe60a46335}},
+		},
+		{
+			fp2elt{fpelt{0x89b4c6f81ca369c1, 0x3aedb2089b18d510}, fpelt{0xa3f482a0e3e87d5e, 0x63af6c9e8f6734fc}},
+			fp2elt{fpelt{0xb4668658e3652873, 0x5e8f37ddd6136c82}, fpelt{0xa7b278274851dc84, 0x7ae2be7eeec52531}},
+			fp2elt{fpelt{0x890de480b29e1a5b, 0x1d95b35e5a1f30cc}, fpelt{0x97a5932e32ddf377, 0x2a3a5e48dc1dc67a}},
+			fp2elt{fpelt{0x299a6c25dc8c539f, 0x2c0955cec1e7c0c2}, fpelt{0x3a8c8e82a1a3b5ed, 0x56f07738b2219e95}},
+		},
+		{
+			fp2elt{fpelt{0xf2a04e0e9863e555, 0x0196426dee0e5508}, fpelt{0x17e3e2deee617e94, 0x566e0a64e14a0364}},
+			fp2elt{fpelt{0xb3118d4b5e1fbdd5, 0x11cb3e232d4a09a7}, fpelt{0xf7a95c756d68e862, 0x5850d781aa990adf}},
+			fp2elt{fpelt{0x58b531bdf621aae8, 0x1840bb223ca35bf1}, fpelt{0x943903231e45b71b, 0x3dcbc8f50ab4fb2e}},
+			fp2elt{fpelt{0x8c8c11fc892d5842, 0x16e7d157d7b6a590}, fpelt{0x11106fa1fe9923b9, 0x06dcd18d20db92e9}},
+		},
+		{
+			fp2elt{fpelt{0x3b91ab5430a737de, 0x351b71716e97905f}, fpelt{0x0a2bdc22f8415edf, 0x3addb2ad1b5cdcf5}},
+			fp2elt{fpelt{0xe1ccbc9f9861eba0, 0x6e010ca7081c35f4}, fpelt{0x0183563df2b5ee3a, 0x0f78df533b03c41e}},
+			fp2elt{fpelt{0x595a752d58e026b4, 0x1a4f2dfb6fd6910b}, fpelt{0x8243a71a6b6a542a, 0x3c09ad84019ad6b0}},
+			fp2elt{fpelt{0xc7a0b47d0e1d5031, 0x6c9e70cd56bd3d41}, fpelt{0xd2950c14ee54f1cf, 0x7c91c495912c534c}},
+		},
+		{
+			fp2elt{fpelt{0xe06b43bffa1148f5, 0x20b1befa69c9e613}, fpelt{0x23027484367d27d0, 0x4c1f84caa1984b65}},
+			fp2elt{fpelt{0xcd5730aebfe881c1, 0x5d6e44a9be8d4374}, fpelt{0xec8c1f03a0482873, 0x299a8ff5a957b272}},
+			fp2elt{fpelt{0xe8ba8e707b0a3b92, 0x0ca2aaf95f4270a3}, fpelt{0xdd1f54099d674881, 0x5e757f54c2a57aa9}},
+			fp2elt{fpelt{0xf5809b1459376c02, 0x710ba27210bf11d1}, fpelt{0x29c2c946b01cc103, 0x77901460162fa2b2}},
+		},
+		{
+			fp2elt{fpelt{0x32421a344e65482b, 0x4a04fbf4234453b5}, fpelt{0xb2b9e24c987155a0, 0x0d81a9092c968c24}},
+			fp2elt{fpelt{0x6fe01852e03fd6c1, 0x7178b452de51c028}, fpelt{0x1e868a9a9ede2c4b, 0x38504c7f6b8f89a8}},
+			fp2elt{fpelt{0xb00edffc0e87893b, 0x54629bf149de3483}, fpelt{0xaac8877f591fe210, 0x2a43ab1997010931}},
+			fp2elt{fpelt{0xc748ed5c6d99851c, 0x00420434f6817161}, fpelt{0xcfc51d26adcfd32c, 0x6286453a30d59bd2}},
+		},
+		{
+			fp2elt{fpelt{0x31d369262331374e, 0x5b631712361297f3}, fpelt{0x0a30656db0039285, 0x5ad612821b1ec5cc}},
+			fp2elt{fpelt{0xce6d606b607a5825, 0x6bf4b5e14eeb3bed}, fpelt{0xe07e7850489e4863, 0x0fd14be83806003e}},
+			fp2elt{fpelt{0x7e52b1b61a0075e5, 0x67a4af623fbb0b93}, fpelt{0x500d60ccc861596e, 0x7aa92ac6ea5261eb}},
+			fp2elt{fpelt{0xf2a0a3e9a9026119, 0x15eae8c2d4f76a5d}, fpelt{0xeb2b744c51df0358, 0x700ec0f3c9a10b59}},
+		},
+		{
+			fp2elt{fpelt{0x488f04972c846ef2, 0x5cc5158e7cfa61db}, fpelt{0x0514319b01922b3f, 0x03328e1ec8cac69d}},
+			fp2elt{fpelt{0xc117971c2183a29a, 0x04dfb945057082fc}, fpelt{0x829cf38d25ba1e5a, 0x183fe0b2613edd70}},
+			fp2elt{fpelt{0x0e4940e60198e03c, 0x1f72b302017df42e}, fpelt{0xc4df72a3f98a25ab, 0x15cf948e1297c5af}},
+			fp2elt{fpelt{0x3a883c13121f317a, 0x2024e29d9396b081}, fpelt{0xcc33502c1e5c2e13, 0x6ac422f7c4926013}},
+		},
+		{
+			fp2elt{fpelt{0x4f443ab2f06a8735, 0x4f9772fd65be1995}, fpelt{0xa44fbd35f97eb66c, 0x4b8998e7b2c70815}},
+			fp2elt{fpelt{0xb8aee8b20cc1d13f, 0x2c6de743a5d97ced}, fpelt{0x7665044b1a66bafd, 0x1491f8dff1e2e43e}},
+			fp2elt{fpelt{0xc73bd44da3d7544b, 0x4c51245333413bbf}, fpelt{0xf8caa6a9b659def1, 0x35e76b07738146a6}},
+			fp2elt{fpelt{0x130288769352ce3b, 0x7e4b9ecc1ae9900f}, fpelt{0x6dbbf6dc16a9ec0e, 0x689179f303755e65}},
+		},
+		{
+			fp2elt{fpelt{0xcab94fbbc3accbb4, 0x00ed457a82c95ab0}, fpelt{0x04128769d8800aec, 0x7d04f3fc40b13a54}},
+			fp2elt{fpelt{0xc12ad0926faf3637, 0x55d0682405a0dcfe}, fpelt{0x45a0c854fe63d9b9, 0x14e7fd4fe99ba071}},
+			fp2elt{fpelt{0x1bac29ef276adb0f, 0x05a3e1764c0bae9c}, fpelt{0x64a70dd1291dbd13, 0x70ad658ec3f5f555}},
+			fp2elt{fpelt{0xf27f7480f6b6c63d, 0x7c7dd88912a1325b}, fpelt{0x1ca832c7848ff95d, 0x562757d6b7c08671}},
+		},
+		{
+			fp2elt{fpelt{0xc13fdf3acd90b86c, 0x1e0e91d3af0aad4d}, fpelt{0xe9d45e7b38bc88a1, 0x7a6ddd8fe3856237}},
+			fp2elt{fpelt{0xfaef23f45765126b, 0x3aaee65ea1e170ea}, fpelt{0xe7e073902761d960, 0x456b0b18196db9c2}},
+			fp2elt{fpelt{0xf1d6d0f369a91793, 0x5b7f2754d9330aae}, fpelt{0x6e3a592ab980d789, 0x456652b49aadb763}},
+			fp2elt{fpelt{0xf266a2e534185291, 0x7ce681a81e49df8a}, fpelt{0xb56eb3f439e1f230, 0x1d90ff2047c0fad7}},
+		},
+		{
+			fp2elt{fpelt{0x6c8152dde48f3840, 0x6f23954015c18b1a}, fpelt{0x2c50263ac2d6d181, 0x608860d0c50c444c}},
+			fp2elt{fpelt{0x26945ee7030d70f7, 0x0133d599446233d2}, fpelt{0x2717f7a9d4f3cf62, 0x6a297a886f1e84b5}},
+			fp2elt{fpelt{0xbec841fe55b42234, 0x10b3afc64baae167}, fpelt{0x1d7843ab1492c031, 0x268694ba40969c74}},
+			fp2elt{fpelt{0x6c1fec5837a6db65, 0x148b1ad8da218459}, fpelt{0x4acde66015488e33, 0x67eee39a9ea7168d}},
+		},
+		{
+			fp2elt{fpelt{0x5c3d5ff6f6dde73a, 0x51e616c4c0f74d5f}, fpelt{0xfbfcb326da83db45, 0x5de7d3df87600924}},
+			fp2elt{fpelt{0x1fb027b59178bbbb, 0x2001bd5dc566a143}, fpelt{0x5a30fbc26ae2ea79, 0x5b082584cec1f39a}},
+			fp2elt{fpelt{0x4aa0f5f64584b759, 0x27fe20a3742a2460}, fpelt{0xd9c76fef7012831d, 0x52b45b39fec8de6f}},
+			fp2elt{fpelt{0x699680a56c71b228, 0x2213f140da2f2d74}, fpelt{0x98de3118cfed3f1b, 0x2b8db61ed28aa0bb}},
+		},
+		{
+			fp2elt{fpelt{0x4a7d4736e46d2ff3, 0x5e13e96e1bc9e202}, fpelt{0xac5af11ad75bfde3, 0x5ef34e66942d58c0}},
+			fp2elt{fpelt{0xeb3e8413697a39ce, 0x18e52684f279f5bc}, fpelt{0x609931bac79d8f45, 0x1053b594a3bea383}},
+			fp2elt{fpelt{0x058e86568e341da4, 0x5e1f62caf9802ba7}, fpelt{0x3e56e437c64a980c, 0x1612fb02820bf081}},
+			fp2elt{fpelt{0x95a7fc5d3b3b3a46, 0x2055203d46115a12}, fpelt{0x2519daa0cae87d46, 0x6d0f14244d32b4f0}},
+		},
+		{
+			fp2elt{fpelt{0x0922a7220689492a, 0x4865606e259bee11}, fpelt{0x7a365aa3deb11c76, 0x3d45a0c130e30f6d}},
+			fp2elt{fpelt{0x0f5be67ca3930d60, 0x5ad3399b08a38e11}, fpelt{0x7464a6af701aa930, 0x3937badc7899fcca}},
+			fp2elt{fpelt{0x44cdc70642e51dcc, 0x5d60d88220e072f4}, fpelt{0x4b9c6dbeb503c88f, 0x4728f8020889db93}},
+			fp2elt{fpelt{0x3f5d06047a5981bb, 0x446d1c314e03cf50}, fpelt{0x596c4ab5fa7ad296, 0x1d3af134c332eacb}},
+		},
+		{
+			fp2elt{fpelt{0x7893a28a401355b4, 0x1cfd264156cdbfda}, fpelt{0xe871e876bb195dc9, 0x68f1bcee7eac547d}},
+			fp2elt{fpelt{0x38fab5a9d07ae6b4, 0x5964b2e43be4183b}, fpelt{0x348ffdd59011b598, 0x313a287b7235ed4e}},
+			fp2elt{fpelt{0x02d3535064d76131, 0x36ab02df25cdfb42}, fpelt{0x20d9c3469c63d72b, 0x6f15b2137ed5c872}},
+			fp2elt{fpelt{0xbe8663cddf79ef3c, 0x0803081461b50bed}, fpelt{0x386ca28b3c95bd28, 0x24d33c32e8aa0bb2}},
+		},
+		{
+			fp2elt{fpelt{0x52afc8897aa980cd, 0x76da47de56fdfeb2}, fpelt{0xff8702b8117136dc, 0x29e0b2e6407f8601}},
+			fp2elt{fpelt{0xc5eba5b701f1e6a4, 0x63279be21d492c37}, fpelt{0x8a205e4cf9461fcf, 0x5ad49ef6132e2db0}},
+			fp2elt{fpelt{0xbea35119292ac387, 0x2df2b4c53480fa6b}, fpelt{0x43f3285575339971, 0x6f451e0221f63473}},
+			fp2elt{fpelt{0xdf489fe5ff91f62e, 0x514d5e23aca9a4e0}, fpelt{0xcce6324dda12826d, 0x40d570e1fbfd9d37}},
+		},
+		{
+			fp2elt{fpelt{0xb971f1104ae6dc14, 0x2a14e23be171888e}, fpelt{0xb989630f975dca7e, 0x73a24776dd0d71dc}},
+			fp2elt{fpelt{0x8c22921e66e9a42e, 0x0ee08875e419aad3}, fpelt{0xe9a649f28c77e2e8, 0x75d6bbcfc9ca6cfa}},
+			fp2elt{fpelt{0x02ab2f7a2236d1a5, 0x51964e9b0c4f88ef}, fpelt{0xf388c04241a6ff51, 0x22b55ac7e6df5282}},
+			fp2elt{fpelt{0x2d0732dc8c6cfae8, 0x54f6515c7dc81321}, fpelt{0x92358a380d79f049, 0x0529dcf2a761bc4a}},
+		},
+		{
+			fp2elt{fpelt{0x4893f4c7818534fb, 0x23b34750d2e14faf}, fpelt{0xfa659106aa863d00, 0x246caddc776cdb57}},
+			fp2elt{fpelt{0x4c9a8d4fd8a7f083, 0x71fd4abc9f6d6be6}, fpelt{0xe1a64c84f78a8e11, 0x2c3a88c5962a2f80}},
+			fp2elt{fpelt{0x228be9ca4cfbf27c, 0x79e7e1fe80ef4226}, fpelt{0x538725af50f85c07, 0x1d34de32a0b0169f}},
+			fp2elt{fpelt{0xb1173b7165f87c7b, 0x4fc75ebd4a90a5b4}, fpelt{0x20238af052f1dd8b, 0x16523af76ccc92fc}},
+		},
+		{
+			fp2elt{fpelt{0x07118addce795b24, 0x22dcea9ecee71bd1}, fpelt{0xaca159cd5218c930, 0x3cb42e14c20d206e}},
+			fp2elt{fpelt{0x0f8aa33ae0d5ebf1, 0x5d71a822f39a03de}, fpelt{0x90984fe4a250e352, 0x34247f2d775ff095}},
+			fp2elt{fpelt{0x076d4926031f342f, 0x7887c1411fd0219f}, fpelt{0xb776197a7d3d84a8, 0x49461bb1c3c27a57}},
+			fp2elt{fpelt{0x6df967340bf20913, 0x1f05a5153d4d2473}, fpelt{0x45f4490c951364ce, 0x1a9599f77cedda5b}},
+		},
+		{
+			fp2elt{fpelt{0x35ed64849896a60c, 0x28dd6b60b80e5e5d}, fpelt{0x223e4968da8016a9, 0x1d1842f748eec0c7}},
+			fp2elt{fpelt{0x7fd9b78fcb936804, 0x5e0f4598a801469f}, fpelt{0xb19e8818f99c7c1f, 0x5bc0ab9650f2d315}},
+			fp2elt{fpelt{0x5d7d8e69fc982d4b, 0x0c6f3c805180bd9f}, fpelt{0x9fd640aa2725379e, 0x0ae921ec4744e7da}},
+			fp2elt{fpelt{0x1460da3df06c8b60, 0x0d7778ca6633f646}, fpelt{0x2978aa58dee569d4, 0x367c5bc6e8817e71}},
+		},
+		{
+			fp2elt{fpelt{0x49c7545aeead475f, 0x12962be1f69efa3f}, fpelt{0x529d9347f6824efe, 0x67b3dbc9a90ccb94}},
+			fp2elt{fpelt{0x82b8718a31daf882, 0x06178a557a72c9df}, fpelt{0x780f10ed851c38f8, 0x520975b7662a2cf2}},
+			fp2elt{fpelt{0x4ee88c77f5946b81, 0x55dee34ed6994a6e}, fpelt{0x91660d5f2ec53aee, 0x4fcdf03c8e96488d}},
+			fp2elt{fpelt{0x2322940935cae895, 0x187c6b2961491a32}, fpelt{0x6897d0d2da3c3de7, 0x3904ad495761f0ae}},
+		},
+		{
+			fp2elt{fpelt{0x97b15eee8d39d0bf, 0x1b87599897bbce39}, fpelt{0xe66d2e51bae5c82f, 0x763eebb7f6692ae9}},
+			fp2elt{fpelt{0x799dc6fa0153887e, 0x3244dc656b7382ea}, fpelt{0xb7a325d398f4baea, 0x640d7e59e9b7fbdc}},
+			fp2elt{fpelt{0xbed6bd95ccd82624, 0x54820db868789a64}, fpelt{0xa6559f882236d8be, 0x1c73e0501549553b}},
+			fp2elt{fpelt{0x5e20c43aa3cf3495, 0x390002338c80a816}, fpelt{0x14335bf8e74931cc, 0x4ae2a6b44d83a871}},
+		},
+		{
+			fp2elt{fpelt{0x2fdcb2d4522c0ffd, 0x3532f06014e48a60}, fpelt{0x1847bf73d2b727d4, 0x1fcda939150da3c5}},
+			fp2elt{fpelt{0x9efdb03cc0370b24, 0x1fb2c0550c85e5b0}, fpelt{0x8a3e3c264e8b2e9d, 0x42c66f4e25b1a8af}},
+			fp2elt{fpelt{0x7916100f121a2ad1, 0x3dba5d7ef23cc31c}, fpelt{0x108d34b381bd2035, 0x1821ac4a30c0433f}},
+			fp2elt{fpelt{0x9856904633a5897a, 0x656ba01c2f437f67}, fpelt{0xfd58c08bc8e19e45, 0x15c96db68eee9d47}},
+		},
+		{
+			fp2elt{fpelt{0xd9420844820e5785, 0x6068097576ae15e0}, fpelt{0xa918b07cf4c13d0c, 0x39227bf8b50c44ac}},
+			fp2elt{fpelt{0x1736709f54e18ad2, 0x3c1aab4ae6acd69e}, fpelt{0x8d52920167069c5c, 0x457282113df1f46f}},
+			fp2elt{fpelt{0x0b78a884cfd31727, 0x36b830213dbec10c}, fpelt{0xf1f0cf9e5cbab970, 0x66e22ec9e1c3aea9}},
+			fp2elt{fpelt{0xfe22142f2e366ea5, 0x124ce1dae05e97b2}, fpelt{0xdc3a3a840da44845, 0x2e5a38c6672c095c}},
+		},
+		{
+			fp2elt{fpelt{0xf9f93edd91d09f16, 0x39b3bb9552bcb658}, fpelt{0x99777df548c0e101, 0x710fad7161a7cd5c}},
+			fp2elt{fpelt{0x2982e02bc10a055e, 0x3d3893f25eb156bd}, fpelt{0x5b35c91f0a606491, 0x25c8a38d59337670}},
+			fp2elt{fpelt{0x930e98abf0df23bb, 0x29e1831787e609cb}, fpelt{0x3a732223afe8c5d1, 0x0ba21ca7095d9809}},
+			fp2elt{fpelt{0xed907d0c4e4a961d, 0x7dd4adcc312dd55c}, fpelt{0xce8e64d0e9e9bbee, 0x5cfbbe5839aefc56}},
+		},
+		{
+			fp2elt{fpelt{0x7360f376c8599e50, 0x0fd6d0d6f0567b09}, fpelt{0xb91306648e3ecc7f, 0x23de59ffc2874244}},
+			fp2elt{fpelt{0x8c894f8e9db8ebf7, 0x04fc2d594f458893}, fpelt{0x1ef260bc313209c3, 0x54297f251a788f6d}},
+			fp2elt{fpelt{0x0d195dfee9d6cd54, 0x2c91a4aeea186d49}, fpelt{0xe348567a6f1ede0f, 0x14da0e23fb0c4b10}},
+			fp2elt{fpelt{0x9ea74640854b057a, 0x269ded4a477ed72f}, fpelt{0xd2c024a8593f8ff1, 0x25a60a74f50058f3}},
+		},
+		{
+			fp2elt{fpelt{0x0d83aac853dc483d, 0x6a58dae6b026d04d}, fpelt{0x6038e0ab7b400057, 0x7350d5aee8956437}},
+			fp2elt{fpelt{0x0ce5298dd82d4019, 0x3802919596b0e2ac}, fpelt{0x2d09b1f114054acf, 0x6b70c9d6e65f2d7e}},
+			fp2elt{fpelt{0xe911e0df4a870fb7, 0x727c309039bfd7c3}, fpelt{0x12db9cf102f06be8, 0x2d0cce68f5c92cad}},
+			fp2elt{fpelt{0x6f5c71daecaa9e77, 0x1d4d37701b814bac}, fpelt{0x4e98c15c7c57dc3f, 0x3d4de87894241952}},
+		},
+		{
+			fp2elt{fpelt{0x2cd81fa44cc44c35, 0x4e70791c424f1561}, fpelt{0x43c464528f108bb0, 0x3d0833abe6e22df8}},
+			fp2elt{fpelt{0xcd7ee2d17525fd81, 0x5c47739fcf255bc5}, fpelt{0xf5f7f058ea6f016a, 0x5d460f147afe88be}},
+			fp2elt{fpelt{0x5890637e754d58d1, 0x0b7a0fc9e2be0861}, fpelt{0xac0534fb52b6c54a, 0x63496540fce18afb}},
+			fp2elt{fpelt{0xf0d3a3cbad8eddfe, 0x2b740e86346233c7}, fpelt{0xc857d8962331d1ac, 0x0fea2a14a0646443}},
+		},
+		{
+			fp2elt{fpelt{0xefc97e38f31c09f2, 0x2f96d37df7ef36f5}, fpelt{0x4d4754625840f067, 0x78829e3479a2b5b1}},
+			fp2elt{fpelt{0x3927862eac25ceaf, 0x0d2c7bee96e15342}, fpelt{0xdaf3c6268d903caf, 0x4f948f329e362b1e}},
+			fp2elt{fpelt{0x733832f504fb481f, 0x005f8ffa01a26ae0}, fpelt{0x2ac248b152478e9b, 0x2fb2c289effb113b}},
+			fp2elt{fpelt{0x7f8b92704d4bca5b, 0x3d983e9eed942305}, fpelt{0xe2c28aa0cce088e5, 0x1e2e47ea346a2903}},
+		},
+		{
+			fp2elt{fpelt{0x65c566b394880e5e, 0x7a106e4795cd26e6}, fpelt{0xf5cd7846c1788d36, 0x4513cfbd17b12390}},
+			fp2elt{fpelt{0xb09b7e96c6f7c282, 0x289c90b67d52dda1}, fpelt{0x876651ebd1f01a1c, 0x3b3dda3f9ec80392}},
+			fp2elt{fpelt{0x9719836aebfd7ac2, 0x420b5d41337cd60b}, fpelt{0x6ead02f7c6f41777, 0x10bd5cd82453b458}},
+			fp2elt{fpelt{0x8fcb38473461ef33, 0x54cca127e49aa469}, fpelt{0x2b1a800e98678651, 0x0f3142c24fe7d1bf}},
+		},
+		{
+			fp2elt{fpelt{0x0bf96865cfa6e429, 0x1724fcfd0c1417ae}, fpelt{0xde73fac3da253444, 0x6685a425592c1b86}},
+			fp2elt{fpelt{0x15e8b77da9872803, 0x73710715a446ec49}, fpelt{0x1073b54e9212284c, 0x667b2e2e3169ddfc}},
+			fp2elt{fpelt{0x10ed3c5d1b89b8cf, 0x33ac6af702339bc5}, fpelt{0x0abef2dbfe1b5db1, 0x1a04829744686b7c}},
+			fp2elt{fpelt{0x6bdc8a9038551cd9, 0x316526327b82e2f0}, fpelt{0xc2daa4fe745341ab, 0x5948be2f783f3163}},
+		},
+		{
+			fp2elt{fpelt{0xd76d045f71d83e98, 0x1d43f2b8b806bcb2}, fpelt{0xfb8c944fe1a1f1ca, 0x1cb5ceecb7355b85}},
+			fp2elt{fpelt{0x0159fbf29bb94d4e, 0x686399c06cd187c2}, fpelt{0x4b0e0f803521b5e4, 0x43286aaa63df04b5}},
+			fp2elt{fpelt{0xd8e17c33b58f8074, 0x712d77ee157a6df9}, fpelt{0xde4622bc5cef80fd, 0x72119aa7334ecb64}},
+			fp2elt{fpelt{0x49d45d7569516863, 0x06b6c781523fc252}, fpelt{0xad8dd55832c05c69, 0x40d19c5f9b5d56be}},
+		},
+		{
+			fp2elt{fpelt{0x0b5b46ea0b315836, 0x660a96f85fc9054b}, fpelt{0x9f4f8cac8877f253, 0x5ea2620d0127a46d}},
+			fp2elt{fpelt{0x0f54595fc4a62ca5, 0x63896ed04d6495f5}, fpelt{0x99575a446b40f4e3, 0x2d0b606132eb19ce}},
+			fp2elt{fpelt{0xe19c4e728fea84e6, 0x7cc438a6e1e5107f}, fpelt{0x9faedfebe8fad023, 0x0e3fe79940153ed0}},
+			fp2elt{fpelt{0xbc29ed5b86e4bcc6, 0x0404cdd12df5bcdd}, fpelt{0x6c20195f50dc727c, 0x763f882aee654518}},
+		},
+		{
+			fp2elt{fpelt{0xbe70f5dc769d4067, 0x2576103630c24796}, fpelt{0x0ef36552a41e8256, 0x081958a0efc3adc7}},
+			fp2elt{fpelt{0xeb343f141b1386fd, 0x2cc06d64a3f61b89}, fpelt{0x7c99ce919af1fbb6, 0x54cc252dc5774771}},
+			fp2elt{fpelt{0xfeb1cb35b7e804ce, 0x68c568a11c968736}, fpelt{0xecd1e9b3648c89eb, 0x2a06ab1e969aa87d}},
+			fp2elt{fpelt{0x9c9264008fcd5f3f, 0x73ec2a7312ed5580}, fpelt{0x2b3a146b029df1d0, 0x70935151404c7c41}},
+		},
+		{
+			fp2elt{fpelt{0x02b457042240ae3e, 0x2664587492cd38b8}, fpelt{0x9efe5c7656b4cf15, 0x75bb043f770b2969}},
+			fp2elt{fpelt{0xb5a10a719931614e, 0x20fba1bf78e4dc53}, fpelt{0x2dc37e11ede1e1d7, 0x60980b04d5c24833}},
+			fp2elt{fpelt{0xa9e386a88b2f1dc1, 0x491ca63f9e8524eb}, fpelt{0x6e6c9e67238b7d94, 0x383daeec39600eb3}},
+			fp2elt{fpelt{0x0604ab4bb8704b1a, 0x25e456ca3d637201}, fpelt{0xcfe8c29241998a5c, 0x6b48ee294763bd69}},
+		},
+		{
+			fp2elt{fpelt{0x055f4ed67c672e09, 0x52fd60f93294a1d8}, fpelt{0x80ed6d4d537fee3d, 0x4e2ae004df5cf9fc}},
+			fp2elt{fpelt{0xba75b098bf11d2a5, 0x46a33687e48ee9bd}, fpelt{0xf76789f2a5c6796d, 0x5de5b1af0fed2d87}},
+			fp2elt{fpelt{0x9bb8b1db9842737e, 0x1a4a9c470b6453c8}, fpelt{0x6843bc87c877444c, 0x76adcc1f19c9fe2e}},
+			fp2elt{fpelt{0x05865769919e3c22, 0x388acb1755055179}, fpelt{0xeec641024c1c95a0, 0x6bfaf82d37f0cff6}},
+		},
+		{
+			fp2elt{fpelt{0x13b7f11f5b913e0f, 0x608a904e747e0970}, fpelt{0x522c94bdef014dd3, 0x707a2aeb54477ab5}},
+			fp2elt{fpelt{0x8696b7514ebefd34, 0x260a24808a9e7ad8}, fpelt{0x48b88bb0f770d80b, 0x133c8f02c78accd9}},
+			fp2elt{fpelt{0x841f587209ed9f1a, 0x07b2ccb23c8db7ee}, fpelt{0x0b1e92472cb36684, 0x2b34ec3bc40a6833}},
+			fp2elt{fpelt{0xa0faa680455a3f8b, 0x4fc2309bb76b8184}, fpelt{0x25e13fbe911948f0, 0x22e4ccdbf766c20f}},
+		},
+		{
+			fp2elt{fpelt{0xd4048c26ca207312, 0x0cdebedcacc5c4d7}, fpelt{0x296431cbb896dd08, 0x7f7947cfa3feff7a}},
+			fp2elt{fpelt{0x2f594a4462df36cc, 0x437e423da2253bef}, fpelt{0xa1ab357821c6ff63, 0x682cdc205a0a36ec}},
+			fp2elt{fpelt{0xc230355261347138, 0x0ca7ea1cbaf641b3}, fpelt{0x606589e235ec4e3b, 0x558d0d8a4c40b981}},
+			fp2elt{fpelt{0x876e76db34599df7, 0x63213225c3ce7803}, fpelt{0xa2c1ad43e48e37ad, 0x49645cd9e1559f76}},
+		},
+		{
+			fp2elt{fpelt{0x1e8d880dbf334df4, 0x6ac3cc5b49c68803}, fpelt{0x1cf469ae717c7d75, 0x4ec8027efff67f64}},
+			fp2elt{fpelt{0x5272f3b599d7dac3, 0x39809e6c8b61a1b5}, fpelt{0xd5aa83b1592f1a96, 0x3a9d9a7cb2a4c276}},
+			fp2elt{fpelt{0x7ed1bc40e1dee2b9, 0x5d4177496f2abf47}, fpelt{0x38de4f4090ab7045, 0x1feafe18d9adc82f}},
+			fp2elt{fpelt{0x828aa382d4b24510, 0x5aa15077e1f3e05d}, fpelt{0x09b2a7e894b62c1a, 0x0322e9b267dc88a7}},
+		},
+		{
+			fp2elt{fpelt{0x91c22d50a6a5442f, 0x60b8faf37780a5f1}, fpelt{0xde9eaa53496fe9ec, 0x3f32207d01a33297}},
+			fp2elt{fpelt{0xed4ee61156a8e2b3, 0x74431fa11b0b05ab}, fpelt{0xeea7f6c02da94eb0, 0x78bbc213a934974e}},
+			fp2elt{fpelt{0xe4065effca1319e6, 0x4eecc48cf38f958b}, fpelt{0x1f2cbd7d05208dce, 0x0b0e26e29b5347dd}},
+			fp2elt{fpelt{0xd0090b43bb27ec99, 0x3d8040f5a49285db}, fpelt{0xcaa7743d40b13c1b, 0x7d9f334d7a25d053}},
+		},
+		{
+			fp2elt{fpelt{0x660910dcda2d0b01, 0x54b7c6bdc322bba6}, fpelt{0x83ddd140f8fe60c2, 0x32664b40e9333a37}},
+			fp2elt{fpelt{0x42f47e472ec34800, 0x60e88cbfd3a17ea5}, fpelt{0xda6d2773d510d998, 0x567f4fcb6a72513b}},
+			fp2elt{fpelt{0x087ccb93c191ce00, 0x57d862c76d6c4821}, fpelt{0x0aef3f8cf5943991, 0x55b9588a61f50323}},
+			fp2elt{fpelt{0x35e118df487bd612, 0x255f96dfb961e0f8}, fpelt{0x0945faca23b9798f, 0x6975c7af2cd77d99}},
+		},
+		{
+			fp2elt{fpelt{0x67a9baad11da92cd, 0x1d5da5c9b931757e}, fpelt{0x62f38863f1b777c0, 0x76cd006853be7762}},
+			fp2elt{fpelt{0x11a827085910e8cc, 0x0a5907400f7980cf}, fpelt{0x512c44e8ef377562, 0x0ca5575035d4361c}},
+			fp2elt{fpelt{0x37b251dd9a27eebb, 0x4ba5944e50c2d482}, fpelt{0x69cd8af3fbcd1148, 0x6ca6ccd77dfa9e40}},
+			fp2elt{fpelt{0x2c142590b4d9448e, 0x4dd95ac5808a0fce}, fpelt{0x8351adfa8fd9738d, 0x677c02d48f783946}},
+		},
+		{
+			fp2elt{fpelt{0x45c5e7ab5f4f846f, 0x4a9264cf7a50e088}, fpelt{0xced86615fe800f00, 0x6173ecd83c5760fe}},
+			fp2elt{fpelt{0xf5b6a31619104521, 0x4b715f1457792973}, fpelt{0x5f89fa392b2c245c, 0x5b41b2c24b624066}},
+			fp2elt{fpelt{0x070f32314a2f9b8b, 0x097bcf7bf3b8b2bf}, fpelt{0xf93fa69b6be4b8ef, 0x3193e2ce244c0316}},
+			fp2elt{fpelt{0xf952390198e45164, 0x4661ab28e0f85534}, fpelt{0x99cfe336a73e05f3, 0x2bb2f41e19ac7811}},
+		},
+		{
+			fp2elt{fpelt{0xecb3a3ce7cdead91, 0x2a959f51f23dd5fa}, fpelt{0x758a8f2d065f8a9e, 0x18637e71ef0d989a}},
+			fp2elt{fpelt{0x4bd76d279060f0f4, 0x615fb44cfd56c58f}, fpelt{0x24404acb6ca443b9, 0x1a822946fff02577}},
+			fp2elt{fpelt{0xd0f5ee2e76cd1095, 0x43feb52dc3ac537f}, fpelt{0xde64ad0971440239, 0x52701e12ea280f62}},
+			fp2elt{fpelt{0xb1b8b3ecb20ea858, 0x200ff3b48db57df0}, fpelt{0xc0d614f9259ae49e, 0x63939a163d16f7b3}},
+		},
+		{
+			fp2elt{fpelt{0x6508aa1a5acd739d, 0x3144b8e21ed52064}, fpelt{0x485bd50afb91e091, 0x64fbb670c43ab276}},
+			fp2elt{fpelt{0xd75e48fdb1b5b413, 0x5a05e2654c6711f4}, fpelt{0xf2fa75f78f8e0429, 0x4637faa8e855e21a}},
+			fp2elt{fpelt{0xfeeaaba251d669e7, 0x3ed7ef77da43c5f4}, fpelt{0x478fdea510574ff9, 0x30d2005821da784b}},
+			fp2elt{fpelt{0xfa883e6f7ef89cf0, 0x00a68606de5c63ad}, fpelt{0xc4054596e7b05bab, 0x21d078c1afb460d5}},
+		},
+		{
+			fp2elt{fpelt{0x3a1b1f528a7e9526, 0x186be734beb7a020}, fpelt{0x13fb7d2c3301de02, 0x5f386eaab355b0df}},
+			fp2elt{fpelt{0x2d82afe826c52ca2, 0x3a83dda559707f13}, fpelt{0xf7978ecfba45a61f, 0x169129d8cde2ab7c}},
+			fp2elt{fpelt{0x3235d0c96b57ff04, 0x39b50f1df031ed22}, fpelt{0xf0119c68257ba229, 0x329646a572063a43}},
+			fp2elt{fpelt{0x59db7272c9d35053, 0x74a333ab8f120e30}, fpelt{0x99a24ab3eb6b3780, 0x61e8a028ac00b935}},
+		},
+		{
+			fp2elt{fpelt{0xaa438f359fe3382f, 0x0742bb024f51b1c9}, fpelt{0x4314ccc2edfed4ca, 0x4689d87754d1025a}},
+			fp2elt{fpelt{0x5c822d40efecbe43, 0x6684e6b4f9ae7fd2}, fpelt{0x340ea6ba3ad613f2, 0x31a6daa589a87564}},
+			fp2elt{fpelt{0x4eb470ef3bb295bf, 0x2a90f8a3643b7233}, fpelt{0xe49325a67c84080e, 0x3aaccf808478b46f}},
+			fp2elt{fpelt{0x20d15b2c317de9b3, 0x57ef60b8fc1d79e3}, fpelt{0xd79e4a6cef3ad490, 0x51ae3d8f30e6dc35}},
+		},
+		{
+			fp2elt{fpelt{0x8c258de344b4f5dc, 0x451425e58ec4f018}, fpelt{0xededc33f6a6833e9, 0x7f4b80f5c0ab2276}},
+			fp2elt{fpelt{0xeea97b49ba15b2cf, 0x781cdea7e94c1cb8}, fpelt{0x33135ade021ec99f, 0x1f3e122f2037ed82}},
+			fp2elt{fpelt{0xf1fa721afbb1da41, 0x2fd9ed4e35d1af17}, fpelt{0x1ad999c640dbcbd7, 0x01e6ae4f30b96764}},
+			fp2elt{fpelt{0x437b856f0d928633, 0x1bbbbc7ba37750ea}, fpelt{0xccdd22cba929beb7, 0x4428fe3d438cda0a}},
+		},
+		{
+			fp2elt{fpelt{0x440d089a62c12e73, 0x42a37fcbc385ccec}, fpelt{0x77a30c861859d3c4, 0x64386e4d9dab3bb8}},
+			fp2elt{fpelt{0xf69255bf3609ec46, 0x38a1d512d7f2e5dc}, fpelt{0x9dc4a9a7ea5b947c, 0x488e75e64c5bafc9}},
+			fp2elt{fpelt{0x54400dd557d17c96, 0x2a2e2653049aa9ae}, fpelt{0x3532032ac5371b83, 0x3318913014a28f1c}},
+			fp2elt{fpelt{0xb92461ed7c51a0ce, 0x7d3f29bdfc63206e}, fpelt{0x9ae565ba1507ecf9, 0x0609ef55a420d146}},
+		},
+		{
+			fp2elt{fpelt{0xb5b4ef09424b45ad, 0x27f07ec924c95b9f}, fpelt{0x346d0cfdc12ff6cc, 0x751700deceb59a91}},
+			fp2elt{fpelt{0x3598087ebdd7dc6c, 0x1fb05cbe80ab526f}, fpelt{0x60ddd79b3be25495, 0x7b955e58df1add72}},
+			fp2elt{fpelt{0xfbcb6241b3eedcd7, 0x49ef7befbf36eddc}, fpelt{0x57b7961b46dabd63, 0x27408774ab68de43}},
+			fp2elt{fpelt{0x91f3bb0e9600fbae, 0x289e3393866da529}, fpelt{0xa0a63eeb634a67e1, 0x4eaf3a6efd0ec70d}},
+		},
+		{
+			fp2elt{fpelt{0x87cb2ac82abec5fe, 0x79dff3116e131cfd}, fpelt{0x4ec81726749bb209, 0x0679df422cc1132f}},
+			fp2elt{fpelt{0x6034f41f7097f1e3, 0x385fe0b37005ad05}, fpelt{0xe0cc1c72f6a218be, 0x48ef16c7185efcc1}},
+			fp2elt{fpelt{0x66b1228f77abe111, 0x426161cb1f42bd4e}, fpelt{0x74dd015706129747, 0x2ce3130775c65c3a}},
+			fp2elt{fpelt{0x53da5eca4c50604d, 0x62a013d3f5b6ef36}, fpelt{0x36788fcd5ea60a54, 0x5424174dec73395c}},
+		},
+		{
+			fp2elt{fpelt{0x1f9a01949ca5d028, 0x4348b4f226cf9d93}, fpelt{0x1e40ee15ddd6fad5, 0x7e011322ec100315}},
+			fp2elt{fpelt{0xf78083affc529e6e, 0x080cd8685eab33dd}, fpelt{0x0eaecf547d14a847, 0x65a7f85299b9712d}},
+			fp2elt{fpelt{0xed3eb3fd9a5e8cfd, 0x6099bf6c26742f56}, fpelt{0x08af148a438b8c7f, 0x12a06c93c97553ea}},
+			fp2elt{fpelt{0xa5e6a6538fcacf00, 0x2e86021bfd4d2572}, fpelt{0x1971596a5e898b1c, 0x5316e60a1c660df4}},
+		},
+		{
+			fp2elt{fpelt{0x53bc726db58bf3e5, 0x50c462110e113eb5}, fpelt{0x826e4535099faf87, 0x49ca963f70095308}},
+			fp2elt{fpelt{0x6879a3e8026d345c, 0x31930e4e2ae19dda}, fpelt{0xec81a39855edabb3, 0x6c64e13c3c01ed57}},
+			fp2elt{fpelt{0x50a9324ff4e6d86c, 0x53f7ec62a3a873c6}, fpelt{0xca3552e164e41b28, 0x0e21d99c9892d400}},
+			fp2elt{fpelt{0x3cc1e107a01bcda8, 0x01ccb9a0fe42a527}, fpelt{0xd9fd734858957757, 0x3a316e43ca0f1654}},
+		},
+		{
+			fp2elt{fpelt{0x36abfa9d1d5049f7, 0x0de023c319adfdb9}, fpelt{0x67b4aa4193b5b5ec, 0x1994147ff83eb369}},
+			fp2elt{fpelt{0x18c7799ad2bd6cbf, 0x47764dc4a57d4378}, fpelt{0xe96238eba080eb80, 0x6b43af0a3c323623}},
+			fp2elt{fpelt{0xe60c81783615d02e, 0x4e72e95d6687ff47}, fpelt{0x49d73fd4ad0b5a80, 0x52ec6379453eb98c}},
+			fp2elt{fpelt{0x85b89c5bf95b6633, 0x4a17fcc8f5c78534}, fpelt{0x3f7306255c590f66, 0x38927cfb27a62077}},
+		},
+		{
+			fp2elt{fpelt{0x888181d92a8e924d, 0x4f5ed09bc9f969c4}, fpelt{0xdcf992e1c8339f45, 0x679c2adfe0cf2b27}},
+			fp2elt{fpelt{0xd83d638dc598f449, 0x0d471ad2a15a9dd4}, fpelt{0xe8ec77c2459f07d8, 0x1e277ac54af1b970}},
+			fp2elt{fpelt{0x59141c022b73147f, 0x62b733e8e62c8341}, fpelt{0xf52ec11535845c1c, 0x5e8c1dd7332dcdce}},
+			fp2elt{fpelt{0xfa8849230949d7d4, 0x07cd3e5727594914}, fpelt{0xe0188f24c36879c8, 0x06031356175bafc0}},
+		},
+		{
+			fp2elt{fpelt{0x9929619362b28144, 0x0e1c1c68c4e9e0de}, fpelt{0x3adccc987af6082b, 0x75a6b8873c7149b5}},
+			fp2elt{fpelt{0xe9d0bc29ad20b17f, 0x2e0b9465238ecd95}, fpelt{0x79d58d846c1f5e24, 0x2b4823f6e8c34418}},
+			fp2elt{fpelt{0x247d7e5f2926ebf2, 0x291d0b2982f054f4}, fpelt{0x17e943d0de152c6b, 0x562658ed80549112}},
+			fp2elt{fpelt{0xce57170d7f2e467f, 0x154c606403fa63d7}, fpelt{0xc49e51e1a86987b9, 0x11352eefca33f368}},
+		},
+		{
+			fp2elt{fpelt{0xa2fa0526fc7881d0, 0x49ae45630e154e54}, fpelt{0xd3f449ddd2a0ce82, 0x26d989311bc7ecf0}},
+			fp2elt{fpelt{0x2ba748466971270e, 0x2b9c28c8fcc29513}, fpelt{0x4289a7a57f881edd, 0x32407772e80f667a}},
+			fp2elt{fpelt{0xa13a49c5ce156f93, 0x7d36046662de3722}, fpelt{0x4ba0692b6740858a, 0x638ca56864a6939a}},
+			fp2elt{fpelt{0x2ca5934bbb3cfe16, 0x6dbc83e8a4fa182c}, fpelt{0xcbba4524bab1f66d, 0x5e898697c2a383e7}},
+		},
+		{
+			fp2elt{fpelt{0x00bb852b0387b06b, 0x13cbb046360b6422}, fpelt{0x1e5cba15894181b5, 0x1665830adb9612dd}},
+			fp2elt{fpelt{0xad67aec8b5ff89ca, 0x5f005df3bdbb7aa1}, fpelt{0x3f5ffad05363d22c, 0x51f7c48ff028d28a}},
+			fp2elt{fpelt{0xd8f54338061481c1, 0x2fea65e092149651}, fpelt{0xac0d0eafd1478175, 0x5d7779e25680e49c}},
+			fp2elt{fpelt{0x45ca7ff2941cf4d0, 0x7d5dfda711f4379b}, fpelt{0x19e91f6c2939ca5c, 0x351cc9df033552b6}},
+		},
+		{
+			fp2elt{fpelt{0xd8c6ab12cbc47968, 0x0933637c62bd38c2}, fpelt{0x8475bfa6a0672cb9, 0x4a5f735087d3ebd8}},
+			fp2elt{fpelt{0xb0aca6777ec1129a, 0x1cb7b5a07485c619}, fpelt{0xca7a91b86741c08b, 0x71dfd4b06892ebe3}},
+			fp2elt{fpelt{0x670155bef4b7cc51, 0x6c9800fa1af70bc8}, fpelt{0x202e2dc7b4c352b0, 0x465653f1f1d502c2}},
+			fp2elt{fpelt{0x3d8a5e2e201fdf3a, 0x13ba7202bc244dc9}, fpelt{0xb57633587288edcc, 0x573422527bffeeda}},
+		},
+		{
+			fp2elt{fpelt{0x50cd5324f9a996c9, 0x709c2c00d6064642}, fpelt{0xee9022937e306f84, 0x255b7b163f9f54a2}},
+			fp2elt{fpelt{0xbad0f9352385051c, 0x700b1de912d1db05}, fpelt{0x6ffe2bdbface7d73, 0x78d55a6103be15ab}},
+			fp2elt{fpelt{0xde590262d50aeda1, 0x2641dc5134c37b76}, fpelt{0x2df96f3ce3c22dd9, 0x4c809d294ab32a43}},
+			fp2elt{fpelt{0x97b45ed034ce11c9, 0x157f0d729a005550}, fpelt{0x875c79cb44b934e3, 0x5c6f16dc2a05f03c}},
+		},
+		{
+			fp2elt{fpelt{0x45e12152f446139b, 0x6326963d1851998c}, fpelt{0xbfcb3ec5bcf767ff, 0x2041be889427a80b}},
+			fp2elt{fpelt{0x2120eca4c0b091a2, 0x421fc2542dd38738}, fpelt{0x80cad1083934071d, 0x6ec50a87dbe3508c}},
+			fp2elt{fpelt{0xc867ad13aa3c138a, 0x35fb5bc84512c4ab}, fpelt{0x1fd92c903df36a72, 0x4445933b6ccfa96c}},
+			fp2elt{fpelt{0x6dda1d4e1a323690, 0x5f04a2b1cd3ee8fc}, fpelt{0x3592ea5b5d91b997, 0x0131a29618262f58}},
+		},
+		{
+			fp2elt{fpelt{0x023b91cc5ebaaefa, 0x6eeec5111af13f26}, fpelt{0x73e3ba2676068b0d, 0x682b909a963638d6}},
+			fp2elt{fpelt{0x3b64b6617786ad90, 0x2029d5fcfa9910ad}, fpelt{0x328c1311ce1efdcd, 0x150fcd68c88efdbf}},
+			fp2elt{fpelt{0xfaf1a3d6a259d057, 0x2c25cf0c4c3a9a68}, fpelt{0xdd0dcea8c391db52, 0x3a7694157ec93165}},
+			fp2elt{fpelt{0xd60039de1c92312a, 0x49cb159d38c5c257}, fpelt{0x23b1e0388b0a99e2, 0x09a3c838208f181c}},
+		},
+		{
+			fp2elt{fpelt{0x4c9ae369c690b6d7, 0x6783c6a904b1a1c5}, fpelt{0x5930c16be6c49f1d, 0x0f11541bde688712}},
+			fp2elt{fpelt{0x7141d5f700e94ad4, 0x221e9c52e6dd099c}, fpelt{0x1a21d3beff406601, 0x00051a3872c53fd9}},
+			fp2elt{fpelt{0x87b5d0e7d39589aa, 0x1ec6f37fa42c3f83}, fpelt{0x6ed3d2a9c43afc41, 0x158cefb65dec2be3}},
+			fp2elt{fpelt{0x11b53ac260ee8742, 0x5f4b8aa1ef730089}, fpelt{0xbce3be4d76803e9c, 0x553d3cd7876cbe26}},
+		},
+		{
+			fp2elt{fpelt{0x375a74580eea6b93, 0x074a79fcfe563b7d}, fpelt{0xe56b410e96ee5102, 0x2aa3db25ffca5290}},
+			fp2elt{fpelt{0x3adf1c8c3fbed674, 0x32eb3b5b99d35a45}, fpelt{0xea4110e36dc5dc28, 0x342c6e5313f760c8}},
+			fp2elt{fpelt{0x89a8501a27acc597, 0x3b5476cfc5664089}, fpelt{0x868c7e5c289a4469, 0x26d183c7ddd7d72c}},
+			fp2elt{fpelt{0xf162dd5dfd1e03cb, 0x37507b97ffe6b8d4}, fpelt{0x6b07518852366ec4, 0x4ad579ef691878fa}},
+		},
+		{
+			fp2elt{fpelt{0x3e0a9f392097a1af, 0x7e3e5195143114b7}, fpelt{0xee6788cf9eab16d4, 0x05b72c2c0ef776d0}},
+			fp2elt{fpelt{0x7f1706acfdafe6db, 0x59981930a6d7ad03}, fpelt{0x84465f5c9815ff11, 0x51c078bd0256e305}},
+			fp2elt{fpelt{0x89d4d6f413baa483, 0x71e6b516a9842af0}, fpelt{0xd894421d27de9f6e, 0x7ea552c92ec104cc}},
+			fp2elt{fpelt{0xac7e0562f8fc9400, 0x6c65561ff8f108ef}, fpelt{0xe6fd35ee6f6040fc, 0x35acd1d96ac4b079}},
+		},
+		{
+			fp2elt{fpelt{0xc624c8ca9f722474, 0x216a093f9bd9a6c8}, fpelt{0x08b8ece42aa57e7a, 0x7ad8975697ccc726}},
+			fp2elt{fpelt{0x98c0b396d0042cb5, 0x113c729e3d20ffcd}, fpelt{0xae336717bf794a47, 0x153682bf842b924f}},
+			fp2elt{fpelt{0x56bd3b506a74d980, 0x66ba7cc0324675c5}, fpelt{0x05a06afe26b88330, 0x731770745e2ca585}},
+			fp2elt{fpelt{0x5aebb2f57a60cd57, 0x616e41de2a452677}, fpelt{0xdb01e2db2feede77, 0x205cf1dd750c9a09}},
+		},
+		{
+			fp2elt{fpelt{0xd94d4b8b16a5ba9e, 0x3b9adb6619d1fa71}, fpelt{0xaca3741296191ea2, 0x7f8893a2402d1db3}},
+			fp2elt{fpelt{0x2c3dede62f1b97c4, 0x3055c8236aea320e}, fpelt{0x5514cd3aaf97a457, 0x3b2d7cc0bdecbea2}},
+			fp2elt{fpelt{0x7f7b7745bf121311, 0x7a348b13971d3bb2}, fpelt{0xa9059ca78c77580d, 0x3965ff50a1928cfd}},
+			fp2elt{fpelt{0x2dc3a3da7e8d79b7, 0x20efeae5c1209db6}, fpelt{0xc4f93e50518db103, 0x5649fb2112b83257}},
+		},
+		{
+			fp2elt{fpelt{0x76334b239a0e5113, 0x30b1e194a12d89a2}, fpelt{0xed11696f8e9f39d1, 0x6202ae6c61d47ff9}},
+			fp2elt{fpelt{0x492c82b8670eaa05, 0x579e8e545fa6fbf2}, fpelt{0x1d0ab72c5d153233, 0x1644647cdf6df1b8}},
+			fp2elt{fpelt{0xb890062933bdbff5, 0x4fefaf6b7771dd5e}, fpelt{0xc67b7d61066365b7, 0x0bfc87cfe465e2fd}},
+			fp2elt{fpelt{0x40c7067c93a33b5e, 0x6ae32b272e0d715c}, fpelt{0x65355a66da753175, 0x1a9f0395acaf9fe4}},
+		},
+		{
+			fp2elt{fpelt{0xc9e1bace2a5e2046, 0x432c8538938266c8}, fpelt{0xb44dcbf46deacbb1, 0x4805d2ec7c2c27a6}},
+			fp2elt{fpelt{0x0eb9e3a0f1d7f3c5, 0x5062e686b7a0db08}, fpelt{0x3b66a37045111c98, 0x0260e88a893b77e8}},
+			fp2elt{fpelt{0x39dd0cc20f3e4db1, 0x04bb32edc10fc7c5}, fpelt{0xe899c4a88d537afc, 0x265630eb83a593b3}},
+			fp2elt{fpelt{0x428c9908e55cc43b, 0x5b6a45609a8c10b4}, fpelt{0x80443c5144ad812a, 0x7e4fcc917ae65bae}},
+		},
+		{
+			fp2elt{fpelt{0x9e566ca44e796b06, 0x7949b6d5edb2373a}, fpelt{0xcb56f60d634bccd7, 0x507f8b5f68e6fc66}},
+			fp2elt{fpelt{0xa875df909dab6ab0, 0x2963ea06c358c7be}, fpelt{0x154d9d40a83fa412, 0x78aa14b6641f6bd4}},
+			fp2elt{fpelt{0x0c55b1c53011a5fe, 0x17d5086bbd0f1b36}, fpelt{0x1fcc46bdaa1b3d60, 0x0bc95cb6c1f6cd7e}},
+			fp2elt{fpelt{0xc37ec2eb5165dc33, 0x79fd6825b385fb23}, fpelt{0x55c0e8d06437a700, 0x0a44f14d971e630d}},
+		},
+		{
+			fp2elt{fpelt{0xdf18af096df02fcb, 0x56b2f780fb577b83}, fpelt{0xda61375dfab2f497, 0x7adcdad9de65a1c2}},
+			fp2elt{fpelt{0x3600c04b6ab1b34f, 0x50ca8f8b9e01c6f4}, fpelt{0x9d7a6c25147864f6, 0x48684b8353f32958}},
+			fp2elt{fpelt{0xf3f062fcc38f466c, 0x14ec41840914e24a}, fpelt{0x710348d59ef48445, 0x54ad85a560460cbf}},
+			fp2elt{fpelt{0x4ff57915c5c63c86, 0x1c718f14bb21e96e}, fpelt{0x5ce920b70140cc88, 0x79aed33fdef9f611}},
+		},
+		{
+			fp2elt{fpelt{0xe2aed22fb01050c3, 0x61271f51f4140ce3}, fpelt{0x83aaf836ea970e37, 0x70aa817d8dd3c975}},
+			fp2elt{fpelt{0xac88c2eded70d520, 0x5dc007b0f0bd37d7}, fpelt{0x847a70646d6212fb, 0x0bb82a35fcaf40d6}},
+			fp2elt{fpelt{0xf183b17fb4673200, 0x73873d727761771d}, fpelt{0xa040e3bc37edb287, 0x32ba5d4ee2a1e56d}},
+			fp2elt{fpelt{0x05885f161b545090, 0x330de0c4455fa65a}, fpelt{0x53c5d4c80aab1b98, 0x4a22bdf7c5b69f87}},
+		},
+		{
+			fp2elt{fpelt{0x8930c09f253970b6, 0x21dcd32bcd0e35ad}, fpelt{0x5a7a99a3d836631a, 0x4ee5007a6da6dc43}},
+			fp2elt{fpelt{0xba8dc8579cfd3f94, 0x6dfc081bd4e1192c}, fpelt{0x0f74b4cbfe214d7d, 0x126126905d3c5a33}},
+			fp2elt{fpelt{0x2f6dd533b8530835, 0x39a26122e4aeb102}, fpelt{0x707f58cbd28abbd7, 0x55115eb29543a442}},
+			fp2elt{fpelt{0xcefa55dd2e658273, 0x42da102272773edf}, fpelt{0x1eb3ed291963a661, 0x2b5d60cdae59b799}},
+		},
+		{
+			fp2elt{fpelt{0xdec4c9594e9d708c, 0x0b8b058abdc0a3b8}, fpelt{0xd754dd974959a2c5, 0x1b1b73226872b199}},
+			fp2elt{fpelt{0x2dbfa4f8a26e2758, 0x446b0f6b181ca68e}, fpelt{0xa38896f3a085bbd0, 0x6a6ceb579fccb66f}},
+			fp2elt{fpelt{0xacce0a60a88f59d6, 0x6e69c8b647b262b8}, fpelt{0xc60569ece9a0befc, 0x14edcfe600c88aba}},
+			fp2elt{fpelt{0x7b377b19b6f8e9cd, 0x432f21b547ae9de7}, fpelt{0x4c38cd0e0263471a, 0x0a2d96b354a2d7bb}},
+		},
+		{
+			fp2elt{fpelt{0xf090cc5e11c8e138, 0x3e13c9f4460e98e0}, fpelt{0x2e4731d07faa3ce6, 0x74f415cdadf1a71b}},
+			fp2elt{fpelt{0x91cc67dd715be261, 0x599cbdd4d494751e}, fpelt{0x162f2206944fb22f, 0x378c18979ff4d447}},
+			fp2elt{fpelt{0x36d8f495139d08ac, 0x648d5ed895aa9528}, fpelt{0xc9d4e2c43d50b0bb, 0x059a4998202a33f7}},
+			fp2elt{fpelt{0xa71dc25730ef0f4a, 0x29629f0bd7301225}, fpelt{0x1acb21b06f3260ac, 0x7ef4b3bcc545ff6b}},
+		},
+		{
+			fp2elt{fpelt{0xc414487db9e3b07d, 0x690ce34fd5debb69}, fpelt{0xd6f975ff56b53bcc, 0x6c137a61710579df}},
+			fp2elt{fpelt{0x4a593eec2408e814, 0x59e05307a7bcaaf3}, fpelt{0xe06af5693fe2a1c1, 0x039800d2a7ff685e}},
+			fp2elt{fpelt{0x629b947a87d28c68, 0x063bcc59e02f428c}, fpelt{0x46b68c9c153d7bf8, 0x51e3c08ea5b61514}},
+			fp2elt{fpelt{0xe38a713f5a04c306, 0x4e61f44fd21adfe4}, fpelt{0x6afd1c0bfdc57e17, 0x238dd9744522cc59}},
+		},
+		{
+			fp2elt{fpelt{0x8e8e7d964228b67b, 0x298ce70455d62316}, fpelt{0x28b04a3a6d17c015, 0x668bbea672647fd2}},
+			fp2elt{fpelt{0xdcc1712c1d8d115d, 0x24dcf69fdd6848ef}, fpelt{0xe6356afe56a22d34, 0x5aedc18509bbb6c2}},
+			fp2elt{fpelt{0x055ba9209627dbd4, 0x2b901205c6aee119}, fpelt{0x6d7e92df50e2bcc0, 0x646b7e5345b309a2}},
+			fp2elt{fpelt{0x116e73e32433cf2f, 0x79ff5b6c4d75e115}, fpelt{0x2f9d984981869ebc, 0x40651444e2da3473}},
+		},
+		{
+			fp2elt{fpelt{0x11d4469d432cc69e, 0x5a8c63d7b82e97b4}, fpelt{0x9ea9f86255a34007, 0x22fe294131a8348d}},
+			fp2elt{fpelt{0x7771e98b4257e5e9, 0x593ef844386da14d}, fpelt{0x0fcdb2c6bf326d2c, 0x085403f67be34ebf}},
+			fp2elt{fpelt{0xecc6fb0d04e79504, 0x2dc147211f185639}, fpelt{0x7c7db5935e72cced, 0x65c4ff9ef121eea6}},
+			fp2elt{fpelt{0xea0ba936b0cf92b5, 0x66d3cfbfba01d276}, fpelt{0x7837d8f0a7bbc999, 0x74a5a94daf22818f}},
+		},
+		{
+			fp2elt{fpelt{0xd5ba665b2998f5c1, 0x72193dab1c1eae2a}, fpelt{0x9c7ec6ab9ccccd0a, 0x744b22e59573cc43}},
+			fp2elt{fpelt{0xa21434326720fa65, 0x4be5401684f97395}, fpelt{0x089416a1f1f5eded, 0x6353fb5fb7591c25}},
+			fp2elt{fpelt{0x9d58005e68801dbf, 0x53f051cd1c33c412}, fpelt{0x780f7dfa0f0e64cd, 0x0686aaea18748089}},
+			fp2elt{fpelt{0x48095da9b2a880ca, 0x16370b3ede5b6c37}, fpelt{0xb82b01788fde0f00, 0x424078698d83c750}},
+		},
+		{
+			fp2elt{fpelt{0x976d654fe0bd4db0, 0x53b0832ea253aecf}, fpelt{0xa6e90942f15e6f96, 0x03805e1ccc3f926b}},
+			fp2elt{fpelt{0xa9807af577a6a8df, 0x2cb421817e7c09fa}, fpelt{0x402be9fb61da87b8, 0x5d00cd4ff3820294}},
+			fp2elt{fpelt{0x078f18a0843ae91e, 0x375f322ea4bec971}, fpelt{0xa6a21ed0eb34d0b5, 0x6e08c5c39dd2f158}},
+			fp2elt{fpelt{0x5659907b85a0e7eb, 0x1256cb167ab172f1}, fpelt{0xaf08b498b021707c, 0x4eac080bcf9ee854}},
+		},
+		{
+			fp2elt{fpelt{0x2b1cd41047624fd9, 0x13637fe0f3cde6ca}, fpelt{0xa08eb3194588b747, 0x058222349a58e8aa}},
+			fp2elt{fpelt{0x70ab835b18ca0065, 0x5ca6713c672ed046}, fpelt{0x19a6cfacb38cbf92, 0x1b13c20db440c960}},
+			fp2elt{fpelt{0xb67bde98a81f98bc, 0x47c472fca03ecf4c}, fpelt{0x1e21a620f1a93cba, 0x25dac8dc8e3e6f56}},
+			fp2elt{fpelt{0xfe3a45b007089a1c, 0x46066d247de9609c}, fpelt{0x4158f26906768fd3, 0x04186dc1a3d9d3c1}},
+		},
+		{
+			fp2elt{fpelt{0xbd4cae8cc2317736, 0x64e3d62659ea03f0}, fpelt{0x37f121d730b5b3d4, 0x0e0fa824dcd31769}},
+			fp2elt{fpelt{0xb07d4ba9909ed42d, 0x3a5351c1515d3f06}, fpelt{0x1612cf2b04f75add, 0x430e1c51299e1543}},
+			fp2elt{fpelt{0x474322e3999232c0, 0x676f948b8ee31019}, fpelt{0xf64e67f94c17f699, 0x2b86781b90326bd2}},
+			fp2elt{fpelt{0xdbdfbdea43dce5c9, 0x72ed25f9914aa23c}, fpelt{0x6a19566bcdd4d69a, 0x04dcad26b0ef8711}},
+		},
+		{
+			fp2elt{fpelt{0xd196eaee31de0fc8, 0x76f06209fefb7fb3}, fpelt{0xa99dfb3b906ab004, 0x41e7d1faf585f7ae}},
+			fp2elt{fpelt{0xbbd18d2d35855fed, 0x455f4a002bb35137}, fpelt{0x7c6283f10a42a441, 0x1d8ae4d5813b3488}},
+			fp2elt{fpelt{0x1b98ac312de3161d, 0x3918b78b6104d0ca}, fpelt{0x8bfd998ac2a11f5f, 0x313c936d93c75387}},
+			fp2elt{fpelt{0x2de223d5da73a3dc, 0x37c6b916d15cf951}, fpelt{0x03fdf9388a2546e9, 0x14fe3f0b11639be8}},
+		},
+		{
+			fp2elt{fpelt{0x87596d68710dc677, 0x49a6d66501e4e6dd}, fpelt{0xb4b23e65727074fa, 0x5e6133d44170d21d}},
+			fp2elt{fpelt{0xb219119b3a0dc0e2, 0x2ee321fd152a84a8}, fpelt{0xfc94aa21eaff6ae4, 0x515fb1d503c3b142}},
+			fp2elt{fpelt{0x6d3fe1460ddebbac, 0x39327ca6d5e5353b}, fpelt{0x2d87ec52f02ff002, 0x2626f31d3d1e44d0}},
+			fp2elt{fpelt{0x48813df859431ea8, 0x35de335ad4cb4e3d}, fpelt{0x34d5e3297b371557, 0x3ff1c469d55949b9}},
+		},
+		{
+			fp2elt{fpelt{0x19d1cba45b869153, 0x4d2ca58f4ffc05f1}, fpelt{0x346a7217a4e8b9cc, 0x21c81d4eac2cc93c}},
+			fp2elt{fpelt{0x0bb813f4818444c0, 0x1433dc6c531eb5c4}, fpelt{0x93f962597d7dafdb, 0x4356f33e35932460}},
+			fp2elt{fpelt{0x4fa4827252c62239, 0x17101632745fed0f}, fpelt{0x89c30d465b3cd34e, 0x7368d5c1a38c3df0}},
+			fp2elt{fpelt{0xe7329205817ba1ed, 0x783f2fa97cc5a749}, fpelt{0x9cf4434570729c7a, 0x29f2c427679b2d07}},
+		},
+		{
+			fp2elt{fpelt{0x11c76b99b2a28d63, 0x1aa1fadcabece6c6}, fpelt{0x9145bcbd8037002a, 0x45b85225ea5b8ce5}},
+			fp2elt{fpelt{0x3069cd18af93e77c, 0x752d30f974c7ea01}, fpelt{0xea09ce439f9c2c52, 0x62c90512ad318751}},
+			fp2elt{fpelt{0x60479d562dac14b7, 0x3aeadb1d1640ced8}, fpelt{0xbaf80c5fdd04d367, 0x52fbdaf0ee4678e8}},
+			fp2elt{fpelt{0x84093d894cff7eaa, 0x62145720fe8243b9}, fpelt{0xa83b9beb0e3b2a5e, 0x5e3078abf8903964}},
+		},
+		{
+			fp2elt{fpelt{0x329b6127a2b092fe, 0x6d3b780f2d983b27}, fpelt{0xbaf92ca3f5c7fa76, 0x6560e85c81d0a7a7}},
+			fp2elt{fpelt{0xd1fbba5a5c3b3b1d, 0x06fe98d1c898b9d8}, fpelt{0x24c7eb3a4c58a883, 0x55bbe02b2a0002a6}},
+			fp2elt{fpelt{0xfdd64607881ff3c5, 0x68042af663fc229b}, fpelt{0xaf8173eb66a05560, 0x3e3f7f4ce1cda3f1}},
+			fp2elt{fpelt{0x81bc15fee7641de6, 0x2a76fc645d633f4c}, fpelt{0x0284d4b93a830e04, 0x03ee2ef557b7cea3}},
+		},
+		{
+			fp2elt{fpelt{0x5c2f2a1c701715cd, 0x2a6cd69ab8ec06bc}, fpelt{0xe967edf81d5c2f3e, 0x6e4a784dde229835}},
+			fp2elt{fpelt{0x3713c76dbbea5941, 0x18ea6a05dbf0c172}, fpelt{0x433d149fb85c93d0, 0x46b094488ef0518d}},
+			fp2elt{fpelt{0xf384c10357c511fe, 0x07b5f306ffb6201d}, fpelt{0xbd29e1f06d238421, 0x1e481e12c7585b29}},
+			fp2elt{fpelt{0x1faf84268a5e3fff, 0x680fb083970f719e}, fpelt{0xb3d70665b2c1e68d, 0x4a98206a640b458e}},
+		},
+		{
+			fp2elt{fpelt{0x5f76b3317946e69e, 0x2ca844fdbc257a11}, fpelt{0xe322fadf7df13f57, 0x5e7a0746e7c5d9f1}},
+			fp2elt{fpelt{0x4629576ccd552505, 0x7de69d921832f0f1}, fpelt{0x269f43229dd4f418, 0x3385f5a2ba9a3536}},
+			fp2elt{fpelt{0x1f8ebb85138d1b60, 0x714bcbc7f4be6d62}, fpelt{0xa8d150fcc9b8cb68, 0x6f1cfc404649caa4}},
+			fp2elt{fpelt{0xe505b18c4060b90a, 0x21a21d522af36b1a}, fpelt{0x2a6f2e39700863e5, 0x44f013853e6abdee}},
+		},
+		{
+			fp2elt{fpelt{0x21107d2013502c2a, 0x7706454a84a7df89}, fpelt{0xa4ee58a13a183b00, 0x12b7e2a5026ef0bb}},
+			fp2elt{fpelt{0x45c638566fe8c6ea, 0x1f092151c4bad508}, fpelt{0x16e95193bdb40520, 0x6cee2a03d1cada40}},
+			fp2elt{fpelt{0xad5a6847190f5da9, 0x3693b93136fd1000}, fpelt{0x87c1d3e8f65e3044, 0x0fbf407acf1a357e}},
+			fp2elt{fpelt{0x6ed010232ef24877, 0x342042ddb41d3e10}, fpelt{0x41306ce4f5692c5a, 0x726e9b2e018f2cd4}},
+		},
+		{
+			fp2elt{fpelt{0x6ea6f5af55cc451f, 0x688d519c5d845af4}, fpelt{0x026b86898e1eca7b, 0x237c2e28be5ceb1c}},
+			fp2elt{fpelt{0xba89a3f8c4d3b633, 0x2ab09a4628225f00}, fpelt{0x56bf9ff67a21b94d, 0x6c23e725ba6ea379}},
+			fp2elt{fpelt{0xdcaac7310592f700, 0x7301976b033b14a5}, fpelt{0x9fdb4f9934de1e36, 0x7cecdafde1566c28}},
+			fp2elt{fpelt{0x45dfa6657e50f421, 0x21130813df846a19}, fpelt{0xb6a4e8d0072c15f6, 0x6e771096ef168391}},
+		},
+		{
+			fp2elt{fpelt{0x015684435932394d, 0x7cf6ac0b157f78a5}, fpelt{0xa5b57f0541ce5a57, 0x61d8165efc66f0ce}},
+			fp2elt{fpelt{0x2ff6782bd0870b04, 0x185f8f9c359f0897}, fpelt{0x18bd1ea31eaea11e, 0x04451ddf4ba36ccb}},
+			fp2elt{fpelt{0x615c3a2f46d8d0f1, 0x6b39dc93af02bd20}, fpelt{0xb4239ab39fc7d68e, 0x7cd0b9d1d7674c04}},
+			fp2elt{fpelt{0xf83118c43f0ec930, 0x5115af2399b3ea59}, fpelt{0xda2674688b1ac62c, 0x554abf4d6f15bff8}},
+		},
+		{
+			fp2elt{fpelt{0x4b2d2d27cef0d35c, 0x132b5609a1e7c99b}, fpelt{0x5df009dde3f8e5d2, 0x3b56da1c72ff8b02}},
+			fp2elt{fpelt{0x59d2542b13bda6d7, 0x1433846ab61870fd}, fpelt{0x84fd00486fd8d8e1, 0x2dd8b5d9a55027ee}},
+			fp2elt{fpelt{0xd1d3383c815c5cf2, 0x54c927bf51b8ff8d}, fpelt{0x577fe97964271975, 0x740f79cef8e2869a}},
+			fp2elt{fpelt{0x87ee6a53808a57e3, 0x40617a22deb735db}, fpelt{0x059e8202837a3328, 0x40abae809c71e43d}},
+		},
+		{
+			fp2elt{fpelt{0x69559be96ce44139, 0x5ff53e1ee80752ee}, fpelt{0x0ab2e6638eba2905, 0x0e19da5f2aba7175}},
+			fp2elt{fpelt{0x01edc3d1d4b4300a, 0x602e3bf983f52e06}, fpelt{0xf12c57512c56d997, 0x6ee4809a74f8abcf}},
+			fp2elt{fpelt{0x0af90e206abdf4fc, 0x6d48eed5659cc97b}, fpelt{0xde8ed838c0a787ff, 0x05701046ed695ad8}},
+			fp2elt{fpelt{0xe248a32d4570aec3, 0x116eef7caababd3c}, fpelt{0x4ad758b6b7f1c029, 0x051d63d728c1c32b}},
+		},
+		{
+			fp2elt{fpelt{0x696b0a93b1cfbb28, 0x764cb0c1b8b1a4c3}, fpelt{0xa75c6d89a0b9cf09, 0x41310385f507ab5c}},
+			fp2elt{fpelt{0x98b98ae324f282d7, 0x53ea7cbcc4da3948}, fpelt{0x0137ee022275cc6d, 0x68a6e27ee38bc300}},
+			fp2elt{fpelt{0xe2707cb110cebd1e, 0x6f6657f30a9d4450}, fpelt{0xdd0f42c757a55449, 0x069f9f9d033578eb}},
+			fp2elt{fpelt{0x41f354e6c1cfc97d, 0x4ce3d21393dcf9ec}, fpelt{0x8fe0867dd83aab3e, 0x24463f301c259056}},
+		},
+		{
+			fp2elt{fpelt{0x6b29637a52cce2c0, 0x008760d127af4458}, fpelt{0x996ad20501084709, 0x26894aa27357f4db}},
+			fp2elt{fpelt{0x452c00a9dcfca5cc, 0x32fc8a5886db2ded}, fpelt{0x59237f591a53c43e, 0x796014c02b17c05b}},
+			fp2elt{fpelt{0x8d2714ce447eeddf, 0x7f2be0e1d4298c57}, fpelt{0xbe944a5bd3ccdb5f, 0x6bc1c041e8452d1a}},
+			fp2elt{fpelt{0xa6739db6606ec99e, 0x43fcc18a7d6aaad5}, fpelt{0x0ec2db4fcdd8cbce, 0x3b63f594795d0d74}},
+		},
+		{
+			fp2elt{fpelt{0xffa8b142d5ecb67b, 0x33fc57df5b5ea3bd}, fpelt{0x63c4a5ec292dcfbd, 0x198185bf5fe4277e}},
+			fp2elt{fpelt{0x5e59fd818df70517, 0x1c6521dd39331c46}, fpelt{0xed4f9cd413cec34e, 0x6dd43279198dd0a5}},
+			fp2elt{fpelt{0x1750bfdea672c764, 0x7f3d453940a6186f}, fpelt{0xb4f581be50f8376c, 0x23b8ab789964d2f6}},
+			fp2elt{fpelt{0xb5bc3e274ff9cdcc, 0x1534e4997b2c592d}, fpelt{0x9d9a7f29768ba27c, 0x31fa35e03900be08}},
+		},
+		{
+			fp2elt{fpelt{0x0ee02a44ae3f7b1a, 0x176377f6ca00e2f4}, fpelt{0x53311f1c98973cee, 0x092b9222a0ab8efc}},
+			fp2elt{fpelt{0x7c00a37729c41fea, 0x09d304b26b57add7}, fpelt{0x28b655b0e967eb8f, 0x3699c1f414811006}},
+			fp2elt{fpelt{0x59c43fb1d26fe791, 0x64a1e07dc9e45137}, fpelt{0x51a6b33a38915766, 0x72ee782e6d8afc6c}},
+			fp2elt{fpelt{0x2e733401498db93c, 0x18c14976a782275f}, fpelt{0xc080e0d4d4df8912, 0x5ebae8a4c3fbcacd}},
+		},
+		{
+			fp2elt{fpelt{0x27ef7dd46b608e92, 0x739d4263ef318929}, fpelt{0xc9f1aa551a9f23ca, 0x570998ee67fa2071}},
+			fp2elt{fpelt{0x7eab7a358217f4c4, 0x5a346a04698d4ec4}, fpelt{0xd0801bd9d56c1ce7, 0x1778ba20d6fda5bd}},
+			fp2elt{fpelt{0xc365b6e28ea238a2, 0x023480b4bfee6f1a}, fpelt{0xec6e3371c7d580e5, 0x3c124023c574fcd8}},
+			fp2elt{fpelt{0xc49f7a805a21b387, 0x0cd64002363976a5}, fpelt{0x4ddc8a7cc7fee8b4, 0x0fe58a8ad01d8cd9}},
+		},
+		{
+			fp2elt{fpelt{0xc2f8d228daf6d183, 0x4042cb172b9b0d02}, fpelt{0x8e77132e3468ec10, 0x195f1d433c39b2a2}},
+			fp2elt{fpelt{0x9f97928c1e9b201b, 0x4663156d7f760f10}, fpelt{0xc7c762c105491b3c, 0x1a3178a42b4384ee}},
+			fp2elt{fpelt{0xe708d2565b3e255a, 0x01ba9df57b5bc931}, fpelt{0x6e903bf770e21899, 0x2333827bd2573ba2}},
+			fp2elt{fpelt{0x4427a6fede5c5fef, 0x5365977307d00689}, fpelt{0x3c39820aca76bcae, 0x55acfbff5817b184}},
+		},
+		{
+			fp2elt{fpelt{0x91cf0d0c47373319, 0x5f63db3f26cbbabd}, fpelt{0xb21d5a70e20ed714, 0x280e3b4d747ff712}},
+			fp2elt{fpelt{0xa6414f3267debe39, 0x68498266b55442ca}, fpelt{0xe680a34086dc79d0, 0x323aee5333b5cda1}},
+			fp2elt{fpelt{0x69794797c011248c, 0x4d1b5b9bc28035fe}, fpelt{0x40d391cb5f7ffaca, 0x44b339f87e3e2f2f}},
+			fp2elt{fpelt{0xf45339866595ca99, 0x6cf07731fb47efc4}, fpelt{0x7bb94f8d2ffb17f1, 0x24052c8eea17151d}},
+		},
+		{
+			fp2elt{fpelt{0x5cad292eb9a0215a, 0x7da73e10187b16b7}, fpelt{0xde295dbea31e4622, 0x31aaee5ba8111b8b}},
+			fp2elt{fpelt{0x7f081a933a0ad918, 0x0fdad1082b26c486}, fpelt{0x59bbce0eb12d284e, 0x62a735f440eb8437}},
+			fp2elt{fpelt{0x3b3bbfdbfe3e020e, 0x26b123e78ece5de8}, fpelt{0x3f3dd19c6fd8e951, 0x3d57e6c1376e9855}},
+			fp2elt{fpelt{0x7d60a4bd7ab0af8f, 0x7e276f2a1f6a4553}, fpelt{0x27c0a3de6cd95faa, 0x2a9f3442be8a6892}},
+		},
+		{
+			fp2elt{fpelt{0x31245d98578776c2, 0x1e028e8c91553d2d}, fpelt{0x2957c85a7ae1bc99, 0x2d68cc369caad245}},
+			fp2elt{fpelt{0x20cf5391af1091c3, 0x3e76fb62624245d5}, fpelt{0xbc06cca7a13ed543, 0x4cb4bec25422c488}},
+			fp2elt{fpelt{0x619acecdcfd2cb88, 0x62a3a148a2fbb7f4}, fpelt{0x9a1e8f26ea187d41, 0x56a945a5c28b2c59}},
+			fp2elt{fpelt{0xe784a6e2958c42cf, 0x15a7d17fc17f7ab5}, fpelt{0x264f4a863e4a6e14, 0x14dc96efb7f813f9}},
+		},
+		{
+			fp2elt{fpelt{0x8a55c94bf2e7785b, 0x386d859775eb9050}, fpelt{0x667dfae52fb2f4c2, 0x04383008cb926802}},
+			fp2elt{fpelt{0x2d4e1aff84a23356, 0x70ed7aced20ed583}, fpelt{0x6f1d3b0415fd1859, 0x566fccecf4a6f7f6}},
+			fp2elt{fpelt{0x601464059ca5942d, 0x6b3e3fb9f27e9123}, fpelt{0x03dcf32f51af15b2, 0x20fd7614f41947a8}},
+			fp2elt{fpelt{0x50bda74bc7f648f6, 0x2f3a09462b300a64}, fpelt{0x80313e1adefbd3a7, 0x58b75ba1e27b66d0}},
+		},
+		{
+			fp2elt{fpelt{0x268596ed6bdac2c0, 0x5da7dbe0376cf941}, fpelt{0xbc245604b5d988bb, 0x6c6f97157a2a7231}},
+			fp2elt{fpelt{0xf1db806d28538b1e, 0x518d2ecb12d263ca}, fpelt{0x74f088333a8631a9, 0x7478b669b2adcb5b}},
+			fp2elt{fpelt{0x5407409227b8c344, 0x551593f8d45a4e94}, fpelt{0x88731ce1d5eb3257, 0x33c291a581d94ae6}},
+			fp2elt{fpelt{0x7596ad1ecf935b2d, 0x66afcf265fb948c7}, fpelt{0x7ec8252297cded03, 0x40e3f0d9f6d01405}},
+		},
+		{
+			fp2elt{fpelt{0x5630747a55908140, 0x30ccb33eb40cd856}, fpelt{0xbc2c0c1de4bdd614, 0x3c17b954279a3951}},
+			fp2elt{fpelt{0x0aace2bd7c4b3e67, 0x54353455b1f775b2}, fpelt{0x975e413c1816934c, 0x5cd4e5030f5e880d}},
+			fp2elt{fpelt{0x450105f3f1cab155, 0x11a6f1c8e27316a1}, fpelt{0x75a6b0985b21a6fc, 0x49c18fc01d1aa6f7}},
+			fp2elt{fpelt{0xae6ea339c08561f0, 0x1b86a76c46003b9f}, fpelt{0x7a02b4ab43e0b630, 0x1926457c6b89debd}},
+		},
+		{
+			fp2elt{fpelt{0x37021c7dae8b212a, 0x0b842366d0047800}, fpelt{0xea47f8df29036947, 0x7f421dfde738c848}},
+			fp2elt{fpelt{0x21cb92b2931db194, 0x473b278bc1ca9680}, fpelt{0xa4164921289c13e1, 0x02f6e71f4486aff5}},
+			fp2elt{fpelt{0x0d7dbbea23fa7a76, 0x5adae0ff1b551686}, fpelt{0x414be8a64453ad64, 0x5dea5e40477e1ffa}},
+			fp2elt{fpelt{0x26f68e5e86b33908, 0x2530ede45423b1e2}, fpelt{0xe8fc5da267274c58, 0x4197ff3e80a3228b}},
+		},
+		{
+			fp2elt{fpelt{0x691c668ec6c06e39, 0x6d81e9c96da3940a}, fpelt{0x4b61fea67396c128, 0x0d8c1a5d9007cc05}},
+			fp2elt{fpelt{0x948eb166e294fcc9, 0x4c07014313f78d9c}, fpelt{0x267bc16ac99aece5, 0x35030bb7baf9b62f}},
+			fp2elt{fpelt{0x8e516763d2a4a197, 0x1c29cb659ab6085c}, fpelt{0x227d4334fd92717d, 0x3eacd8e6849f9488}},
+			fp2elt{fpelt{0x19d5cb63de0728da, 0x1f8210cf8abb0411}, fpelt{0x2f5af73e2201730a, 0x5213f08fb0643ea4}},
+		},
+		{
+			fp2elt{fpelt{0x0fb3acc95423ba8d, 0x0f9870b060d4a1bc}, fpelt{0xbcc3c610978868df, 0x61bc72237186e022}},
+			fp2elt{fpelt{0xbe6e4b2b0fd2dd47, 0x63d44419b0871864}, fpelt{0x962626b54336534d, 0x74f12211d4286ef3}},
+			fp2elt{fpelt{0xdb39f43a11878c99, 0x4bc6d4f72223f145}, fpelt{0xd63f7c635924f533, 0x3ee66b67b3b8137d}},
+			fp2elt{fpelt{0x873435c70a39435b, 0x39d2a5af6e277ad0}, fpelt{0xeb27cfa67bc325cf, 0x4b5eb8b35781e235}},
+		},
+		{
+			fp2elt{fpelt{0xc45b4021308bb5cc, 0x54865b0d67e969fb}, fpelt{0x001a2bf90936c4c4, 0x0996da227102c69b}},
+			fp2elt{fpelt{0x1b40b74a4aa08dab, 0x365b925fc5796103}, fpelt{0xcfd78d22df493c7e, 0x708d792b8abd880d}},
+			fp2elt{fpelt{0xff816458d8595e43, 0x089c8f2c3ac1e583}, fpelt{0xfbe82e95c1a26631, 0x25d341c29fa7b05c}},
+			fp2elt{fpelt{0xaf55fdb4b2906f73, 0x254832c6f778b782}, fpelt{0x7408d1c09c5cb0b3, 0x342bc6d3987fb40b}},
+		},
+		{
+			fp2elt{fpelt{0x76280e899704ea12, 0x7f851fba420c6499}, fpelt{0x7a08214aa6408d86, 0x3b395596c6452a94}},
+			fp2elt{fpelt{0x23a5a5616b60decc, 0x24ce97fef6120b74}, fpelt{0x3fd931d618e6a948, 0x79d949a26b232461}},
+			fp2elt{fpelt{0xb2919eb346d4a55c, 0x18428851c7bbcc98}, fpelt{0x10be01e916b60593, 0x0e39ff9d6afe5111}},
+			fp2elt{fpelt{0xe1a72ef2ab6771d7, 0x2045388fbcb32568}, fpelt{0x3886c4b4d27f55d9, 0x3a5c9b103c5350fb}},
+		},
+		{
+			fp2elt{fpelt{0x2515dabe07fef75b, 0x1ba6694665457309}, fpelt{0xbda527d3ea646b4e, 0x530c038e77f1e45a}},
+			fp2elt{fpelt{0xd8b2623830929e1c, 0x77e801b21d37c3e8}, fpelt{0xcec8bcc8dab4385e, 0x6161bf2438a992be}},
+			fp2elt{fpelt{0xc8dd939642972c0e, 0x2a731086327987ed}, fpelt{0x1507a687c96558d1, 0x7c41b7d8b3219279}},
+			fp2elt{fpelt{0xc1c91fe0d350f3a3, 0x72099f8f5f41277d}, fpelt{0x20d232e4b2c831b8, 0x736d6adc3be78350}},
+		},
+		{
+			fp2elt{fpelt{0x6a0cdb6752f7e1ea, 0x1769a5b0965b6e52}, fpelt{0x61b31df6443c293b, 0x68132dabe3f373b7}},
+			fp2elt{fpelt{0x434a7ec0edde7120, 0x5945aef5eb01944a}, fpelt{0xf5fea7b4b148aa75, 0x74d8180bd24aa461}},
+			fp2elt{fpelt{0xd65601d8c04eb02c, 0x42768b9db0101b9c}, fpelt{0xcccf982c406b8c16, 0x0a61b752c36158a0}},
+			fp2elt{fpelt{0x31365327404d766b, 0x7fe35ad79ad07328}, fpelt{0x507f3c767882836b, 0x477cabf86f4321ae}},
+		},
+		{
+			fp2elt{fpelt{0x360006ca2cc6f8e0, 0x0c70776582b57ff9}, fpelt{0x1667c352bd4b519b, 0x2f3654462989a259}},
+			fp2elt{fpelt{0x00c58f1b0e5d0ed0, 0x01671dd79dce7a4e}, fpelt{0xfbb6b4f0b3354eb5, 0x590ee95cac13eb51}},
+			fp2elt{fpelt{0xfe3cc191828c3e6d, 0x12cc50c347ec76e3}, fpelt{0x5a994c2fc0b4ecc8, 0x54e7236c1e508778}},
+			fp2elt{fpelt{0x48ee54a7c71df254, 0x5a70855ae0d435ad}, fpelt{0xe12f1350a20a1257, 0x37bf889e39938689}},
+		},
+		{
+			fp2elt{fpelt{0x45a8c6e1c86ff96e, 0x65121005f69ee00f}, fpelt{0xe7ba639d493dddcb, 0x392c3dbc9f02b58d}},
+			fp2elt{fpelt{0x0dcc0b67a63d1c04, 0x7d4c93e8a3655d27}, fpelt{0x2ed2fe3438f7a839, 0x7f43a93029e3b5ea}},
+			fp2elt{fpelt{0x37560e9c64eac35d, 0x7c35d23649da2e18}, fpelt{0x8e2b997717c3e108, 0x6d8894ba46e5a03f}},
+			fp2elt{fpelt{0x0499f174b52b598f, 0x7ddcf4486adede18}, fpelt{0x0391ec077dc06f7e, 0x7e9a6691afe3f15d}},
+		},
+		{
+			fp2elt{fpelt{0x8b64b4bcd36df831, 0x453b106755cfd5db}, fpelt{0xca0dab2dc1c50025, 0x0301dae9f84d6b5c}},
+			fp2elt{fpelt{0x88b0de3cf3d43bae, 0x6580829cef5098f3}, fpelt{0xabd41b24718d9a7a, 0x102d185db4dbc4d0}},
+			fp2elt{fpelt{0x398cd4ac7d28d528, 0x7017aa52b347a73c}, fpelt{0x3f2a64afa184f8e4, 0x51493558c7848560}},
+			fp2elt{fpelt{0x9e4ded62af780cad, 0x081be3e1883e7a83}, fpelt{0x0de67f739eaef509, 0x0bb078fc1e24833e}},
+		},
+		{
+			fp2elt{fpelt{0x2037c9de1e6e3a90, 0x5648a13e4d96229f}, fpelt{0xe7f43772a7e422f1, 0x35ba3d9cc62a5a35}},
+			fp2elt{fpelt{0xd50e800bc113bbee, 0x5483a9039335a4ed}, fpelt{0x5ee28e0a740afc54, 0x741accbb8e3d543d}},
+			fp2elt{fpelt{0xafd88c6971d9a5da, 0x58336b77078f9ca2}, fpelt{0xabd8491170e36431, 0x076caa21969840f8}},
+			fp2elt{fpelt{0x0bacbb5267eaa7e8, 0x0576828717502d59}, fpelt{0x69270329348c8062, 0x184e923137f0b817}},
+		},
+		{
+			fp2elt{fpelt{0x80a8d847c0dffeb2, 0x580d15e67c72b533}, fpelt{0x284cda71faf38bab, 0x46ea7e07578b4945}},
+			fp2elt{fpelt{0x7140605c94ff25fa, 0x027da0eb53a70300}, fpelt{0xed4be0b508efa1c3, 0x3de5e708b43e9632}},
+			fp2elt{fpelt{0x86dd737c1ccc3873, 0x023f87273236feaf}, fpelt{0x65939ab1258ddbbc, 0x7e9ef48a21e4eaf6}},
+			fp2elt{fpelt{0xacf381fe02f2a38a, 0x7cf3bd100e38d337}, fpelt{0x452e43b6271b65f3, 0x2523cfd9df8a37bc}},
+		},
+		{
+			fp2elt{fpelt{0xe20568dd9cefc030, 0x5291d4f0913aeb68}, fpelt{0xa0ac4f2bee54218d, 0x0293ddb5c40e1c20}},
+			fp2elt{fpelt{0x09e2cc61a81091cb, 0x48cc758dd71632ef}, fpelt{0x565a98f7597da250, 0x00f836eb31e92078}},
+			fp2elt{fpelt{0x9e895f30e2e4953f, 0x7bb692bb96af47b9}, fpelt{0xe6d40cb514797876, 0x443dbb442f831cdd}},
+			fp2elt{fpelt{0xfdaeb27ffddabf92, 0x13650cff4a054bea}, fpelt{0x95fffa7a1d99e10e, 0x6964eaa7c2b26e67}},
+		},
+		{
+			fp2elt{fpelt{0xb893853212457efb, 0x7db8ee8effad564a}, fpelt{0xe3fb8533e0f7d8eb, 0x424c4f4fad6fb8e4}},
+			fp2elt{fpelt{0xc981466fb470bd6f, 0x0fefef2785865054}, fpelt{0x9d056bb34ff78259, 0x091f063e22afb2f3}},
+			fp2elt{fpelt{0x9f74e19edfe4c9e3, 0x4d27c80a4c874fe8}, fpelt{0xf1a1a332b5263a4c, 0x48c1854ff96b4f6e}},
+			fp2elt{fpelt{0x3983e582c7488d25, 0x42e4daacd7a53c52}, fpelt{0xd400914a43913849, 0x73e5053b8f6b4037}},
+		},
+		{
+			fp2elt{fpelt{0x4b7b7c9bf74b2e8c, 0x695206c809816176}, fpelt{0x29860a0ab67b3d70, 0x6be6a2f2b2512a5a}},
+			fp2elt{fpelt{0x066d24f5965e7aee, 0x2af86952ba3eb094}, fpelt{0xa4bd2f83e52e4ca8, 0x7fca28bc8c14663b}},
+			fp2elt{fpelt{0x7521cab19fcb0293, 0x34b87e4e3e7f179a}, fpelt{0xa090e5c1ee0e4e13, 0x1b6c5e9c27c1177d}},
+			fp2elt{fpelt{0x11a07b36d356699f, 0x604d650cf885a14d}, fpelt{0xff412d80b0c3d83d, 0x4668ba6dcbaf8c85}},
+		},
+		{
+			fp2elt{fpelt{0x05db8ac6c41c2540, 0x08ff7f6ea5fb30ac}, fpelt{0x5ce9640a71d7082c, 0x13e911bf43911eda}},
+			fp2elt{fpelt{0xdfce9a7afd2dc0fc, 0x30fd84ad29f9c242}, fpelt{0x21a44f3609131d88, 0x19ce40e7290e855c}},
+			fp2elt{fpelt{0x65feb0aa257c3bd4, 0x0a6b7ab6c810e248}, fpelt{0xfce73d5eaf994a3f, 0x1206f78185b8c19d}},
+			fp2elt{fpelt{0x907aab6ad06a8089, 0x694b52d172040cd9}, fpelt{0x330b936ba46e8067, 0x2e6bff0231f1fcaf}},
+		},
+		{
+			fp2elt{fpelt{0x8537aab90f4e0484, 0x2e74599b594fdb9f}, fpelt{0x25b12aafbb43475d, 0x2427766ddd2841cc}},
+			fp2elt{fpelt{0xa7b838d33cd6d265, 0x4d2cc92dd367fdfc}, fpelt{0x756d23831bea1fe7, 0x5ecbdf880a89aead}},
+			fp2elt{fpelt{0xdfd336e59ce28ef7, 0x3fd891aa36292da5}, fpelt{0xa5a0b469d4afce29, 0x268635c47d1fe5f6}},
+			fp2elt{fpelt{0xd949328f70a8e28c, 0x5aa67f56b38fbdf6}, fpelt{0xf0570ac890b05fa1, 0x555c893d4de7b3c2}},
+		},
+		{
+			fp2elt{fpelt{0x6d918f35871e177d, 0x201e0d6a3fc6cff3}, fpelt{0x914f166636aa5ede, 0x1d3cae1b7f6d6ef0}},
+			fp2elt{fpelt{0xc308f2c28beb3758, 0x33f673d41d7e34f6}, fpelt{0x5e4bb0a197b5322a, 0x5d48f866bd3a7236}},
+			fp2elt{fpelt{0xed92e627f536fa05, 0x618b6c0b1eae21ef}, fpelt{0xce3c492cb26bfbc3, 0x4024f4d08ddf07ec}},
+			fp2elt{fpelt{0x6d2e5835554a4622, 0x6662ccea5999b90b}, fpelt{0x1a1760b96c67791d, 0x3d2569f2b3493848}},
+		},
+		{
+			fp2elt{fpelt{0xf674287af564c80f, 0x2d5f6b3adbf0fdca}, fpelt{0xa022f6d58e90679f, 0x0dc586cc52d214b2}},
+			fp2elt{fpelt{0x5235b3d890a22d0c, 0x227f67044ed36389}, fpelt{0xfd8242c7ee4139c6, 0x264ccefc4cb9b098}},
+			fp2elt{fpelt{0x22e9d6a96883b775, 0x5a496fe471ec3aeb}, fpelt{0x17ce5baa87a2f183, 0x300857d9c9d343e1}},
+			fp2elt{fpelt{0xbf0452e9515d6192, 0x5c8cab502e0e93a7}, fpelt{0x62a8edccaff29d95, 0x379ebb5741d72eb5}},
+		},
+		{
+			fp2elt{fpelt{0x5a7d0a622b929637, 0x7e7c2f8dea075cb5}, fpelt{0x55bb28d0a0b21b10, 0x00559f55d5e0377d}},
+			fp2elt{fpelt{0x969d8adb37ea4088, 0x569418696e62b277}, fpelt{0x509031aadc198d53, 0x2c491a1c66ebd5a7}},
+			fp2elt{fpelt{0xe66ed24323ad3d7e, 0x0226db15ea6005c1}, fpelt{0x95e5c235b39392e7, 0x4f72d0614c7f3722}},
+			fp2elt{fpelt{0xd03cab38ff6822ae, 0x38b5bc6509e2e89e}, fpelt{0x1048fcd0234776fc, 0x174dd500707b576b}},
+		},
+		{
+			fp2elt{fpelt{0xd8c35bb52d10461b, 0x11daaae318455afb}, fpelt{0x0759041549573264, 0x588bac596a9731b3}},
+			fp2elt{fpelt{0x8e5923ae63f282ad, 0x36e9f97c1262d6e3}, fpelt{0xbc9379d1ac32b7d1, 0x70ff8de40d4d3d1a}},
+			fp2elt{fpelt{0x266605e8f02abda0, 0x56bd2380de538773}, fpelt{0xbf9127891c5c47d1, 0x4a508d6a689f9a1d}},
+			fp2elt{fpelt{0x384bf64dc116c9a0, 0x56eee2a139e73017}, fpelt{0x0e88593b7729b368, 0x5a829b060547ca71}},
+		},
+		{
+			fp2elt{fpelt{0xb05060615c71d8bf, 0x03d328e6caa8165a}, fpelt{0x62304f8de533d491, 0x48cf102fc7212170}},
+			fp2elt{fpelt{0xdccd16246d48008e, 0x051b9320c7f9ad18}, fpelt{0x4df9f83960839131, 0x504ddcb31387cff7}},
+			fp2elt{fpelt{0xcaa97abf9c9fdebc, 0x7ee87861060a336d}, fpelt{0xf80b37a46798d2b7, 0x0f4cb5bb9c306e67}},
+			fp2elt{fpelt{0x47cce2ce0c6d442a, 0x727b047179596545}, fpelt{0x5270e10f105474b9, 0x7fec12429fa32ce8}},
+		},
+		{
+			fp2elt{fpelt{0x3304445ac4899c10, 0x6344ec543f285ea9}, fpelt{0x9538f75c3818461e, 0x517f47d6db60c34b}},
+			fp2elt{fpelt{0x91ccdc25ae80b662, 0x03139696cc568ad0}, fpelt{0x8ab987884bffc89f, 0x3ef04805926e7f08}},
+			fp2elt{fpelt{0x2c5ec3d638375c61, 0x3456a6fe65d1948b}, fpelt{0xb41d39dce3a26b57, 0x5583b319bdfdaae8}},
+			fp2elt{fpelt{0xff0a9ce02148443c, 0x415c15e4b88ab507}, fpelt{0xd6ef79f44d4c5100, 0x711fb9ba3d2a2bd3}},
+		},
+		{
+			fp2elt{fpelt{0x4582487200354ff9, 0x6b79a9f11f46401e}, fpelt{0x271e44729e001914, 0x6f7441abb07ee2c0}},
+			fp2elt{fpelt{0x2360669af3677ef7, 0x76b8a679b8b7c267}, fpelt{0x970424b7480d2ea9, 0x714b0686fda52361}},
+			fp2elt{fpelt{0xe52ed4a31808c797, 0x2ece327e42e2651e}, fpelt{0xd123d743bd4042b8, 0x42168a371e7f3201}},
+			fp2elt{fpelt{0xa4304991f9512690, 0x550edb8594c4a627}, fpelt{0x6fbbf914a52276d9, 0x372f34df195ae464}},
+		},
+		{
+			fp2elt{fpelt{0xcae75ce582cb7b02, 0x2a929cc0ce5d7b96}, fpelt{0x576bdc88ded75091, 0x4eafb7cd39ab9215}},
+			fp2elt{fpelt{0xde00b5439b531677, 0x355c25328ae487ad}, fpelt{0xdf2f1fe22bb3fbf5, 0x663df58313830bcd}},
+			fp2elt{fpelt{0xec49a8f4ccc3ef2b, 0x6c1bb6d6fc6f771b}, fpelt{0xc1bf9bb78f08c098, 0x1b0a9d5a77c7792e}},
+			fp2elt{fpelt{0x6b3890ea608fb72a, 0x448db2a79f5c7479}, fpelt{0xf03532412f1a0129, 0x7ad2d92b51b41cb0}},
+		},
+		{
+			fp2elt{fpelt{0x28ce39e08993a511, 0x560882f14ce27ea8}, fpelt{0x656b359af1a314c2, 0x01742c4f9e9cb8ca}},
+			fp2elt{fpelt{0x2c73a39ac60bc778, 0x577aa3f9585c836e}, fpelt{0x98cafd855833d7ef, 0x762e7e57e5415744}},
+			fp2elt{fpelt{0x724ab796eaedd39a, 0x0f2472bce797d9e8}, fpelt{0x777179ed2704985e, 0x1a621c0bb78360d2}},
+			fp2elt{fpelt{0x95987ca8af2c306d, 0x01a461a2627ecfbc}, fpelt{0xb738fe8468bfb537, 0x6217a776d0a71dd1}},
+		},
+		{
+			fp2elt{fpelt{0xdb84f3c8c5858973, 0x4a9b365f12139375}, fpelt{0xf9ade1cb8a07faab, 0x4bd24a21984d8446}},
+			fp2elt{fpelt{0xf9833d9c99f237f8, 0x0aa0692c52915dfa}, fpelt{0xda6f058f2c827019, 0x0de922f7e727d102}},
+			fp2elt{fpelt{0x47e1632b5b82555a, 0x562066b390c0af3d}, fpelt{0xa1a1981163cf6f57, 0x3c9f527d8780c476}},
+			fp2elt{fpelt{0xfcf1b68848118062, 0x02ede377297910ae}, fpelt{0xa8380e1fadd20d37, 0x5e691eed2d63e2cd}},
+		},
+		{
+			fp2elt{fpelt{0xd974eaf80ece3106, 0x78bc29e0e325dac3}, fpelt{0x24955b655a893b33, 0x56dafee0e61bb2c7}},
+			fp2elt{fpelt{0x7d1b8364cc502379, 0x6a55cbd6901407c1}, fpelt{0xc495092260a7b6ae, 0x2cfd0396bb11d380}},
+			fp2elt{fpelt{0xdbb0d53ff9d0d1e7, 0x492ea1ed354db250}, fpelt{0x5d0b4878788b8257, 0x03fbadfa0c2bbfe7}},
+			fp2elt{fpelt{0x5d247eae8412414e, 0x4af3f9ccffb1cab1}, fpelt{0x4e83eb5717dd5ca7, 0x078e20219575c6b8}},
+		},
+		{
+			fp2elt{fpelt{0x792a75012cbf7799, 0x7d10c93d243587f7}, fpelt{0x0d77feb14c6e77b9, 0x12d86c22f2e17b32}},
+			fp2elt{fpelt{0x49ce6d16141f455f, 0x1e3c42618fa3d6ff}, fpelt{0xe3c3357040d49303, 0x1d0f279be9af6144}},
+			fp2elt{fpelt{0xe44147492f234571, 0x745b194e793bfd6f}, fpelt{0x9add794e8c68cb8c, 0x2a403ccd8d5879af}},
+			fp2elt{fpelt{0x23c3c6162fc5e9d6, 0x57c1b7a2e695467f}, fpelt{0x28c33ec9b8a35046, 0x0dace84b570a1566}},
+		},
+		{
+			fp2elt{fpelt{0x9012f1f839edfbba, 0x020310a7e5ece233}, fpelt{0x7313db15571f49f4, 0x21fcdbf531718148}},
+			fp2elt{fpelt{0x6d65986f78d05541, 0x525364c735d48b20}, fpelt{0x6faf8b19db129720, 0x1514eed4b00ca4a2}},
+			fp2elt{fpelt{0x655cf926f29e36c4, 0x662d0f2e6731fd2a}, fpelt{0x7176bfb3611c4f29, 0x5f66751b1dbfd226}},
+			fp2elt{fpelt{0x0393885e2b5230bb, 0x5d342ee7e81fbedf}, fpelt{0x562d246d74965490, 0x3a75a6590c3755b9}},
+		},
+		{
+			fp2elt{fpelt{0x2129946eed0e3dcb, 0x431f60f12ff098ed}, fpelt{0x15caab6e918e7bbe, 0x721d7f40b59c75da}},
+			fp2elt{fpelt{0x2639a21408904dc9, 0x53b1d42dcbc116b8}, fpelt{0x9c341ad405df83e9, 0x0395d5479d50b26f}},
+			fp2elt{fpelt{0x25d2de7d030d01b9, 0x07831454421bbb38}, fpelt{0x0e35aa2bbb826ae6, 0x057e6ba9553928e4}},
+			fp2elt{fpelt{0x5c0a963714eabedd, 0x4f2b7ae509f64b94}, fpelt{0x83b5bb1e97365730, 0x20c9ca2ccbfa5e63}},
+		},
+		{
+			fp2elt{fpelt{0xf4d1352188932cfb, 0x3563a80c00b4c6d4}, fpelt{0x4981634bc345c0e5, 0x179b7716d1709142}},
+			fp2elt{fpelt{0x8462db9db2fec7b5, 0x1e5ee1392cac32e3}, fpelt{0x82d5ba643e8c3d33, 0x1221ae3a77c4c668}},
+			fp2elt{fpelt{0x22f944e5d47ef930, 0x0ce56003357f0ec9}, fpelt{0xc317cb80c0647a28, 0x56783626e704a156}},
+			fp2elt{fpelt{0x88027f9a66fa2623, 0x1f37950e5ca467ef}, fpelt{0xab8cd99cef78b838, 0x716e34505087ac26}},
+		},
+		{
+			fp2elt{fpelt{0x7a94d834d29bd628, 0x187ed3fa1aa3df96}, fpelt{0x491c09eb1e1123f2, 0x38fe577570959666}},
+			fp2elt{fpelt{0xf5d687243b497202, 0x7380c4a91c91ce7b}, fpelt{0xcd86a4d3c3c2d17f, 0x2fbb37eb7001fa82}},
+			fp2elt{fpelt{0xd365c349f6202773, 0x267526ddf00cb622}, fpelt{0xc2f99c330a8a88c4, 0x3e782adcdf79b1fa}},
+			fp2elt{fpelt{0xdb3b0793c30ca438, 0x6910190c1577c5fb}, fpelt{0x72dffe2938261ed7, 0x63c953f5522f8a2f}},
+		},
+		{
+			fp2elt{fpelt{0xf1d30d9a6c77b48f, 0x50e9bf5812b9c3b8}, fpelt{0x7fdce6792e890a74, 0x67ff9fd0d1f2a0d4}},
+			fp2elt{fpelt{0x07789aa4f2fe2654, 0x44e1fa2a48b84e96}, fpelt{0x0818fee948baee0f, 0x0f77ec790e23caa2}},
+			fp2elt{fpelt{0xf7a1cd3e28e54517, 0x16011095b3210bec}, fpelt{0xe205394c54b3eff2, 0x1978a170365f33b5}},
+			fp2elt{fpelt{0x5a6de67eef2d35ea, 0x0d9ccf1c279db21a}, fpelt{0x8f062d9ab025ea44, 0x12924c5a946512ce}},
+		},
+		{
+			fp2elt{fpelt{0x70b311549c6174ac, 0x6a98e8cd33f3d270}, fpelt{0xe8fbaf2f3115b1d5, 0x6a20f932dcdc1381}},
+			fp2elt{fpelt{0x5689b4614535ca1e, 0x608b3ea66a8e0d20}, fpelt{0x695850caabde23ef, 0x6a1adeea5f413fe7}},
+			fp2elt{fpelt{0x667752ff45509ef1, 0x6e0368c234ca0fd9}, fpelt{0x65d5a071bd3d9fb9, 0x1636445d23d373a5}},
+			fp2elt{fpelt{0x254d25a78fe2e7e7, 0x02efc0783cd7814d}, fpelt{0x590611020943c306, 0x19f531ff023ee080}},
+		},
+		{
+			fp2elt{fpelt{0x6a050819f8687995, 0x43d7e3d9331fba08}, fpelt{0xe4733a82b23f83d7, 0x07b3539ee9f4f08b}},
+			fp2elt{fpelt{0x1b7b1c4a68c810aa, 0x0788f85c41e3f0d5}, fpelt{0xc61be7709a2ed074, 0x7d9a684c25fee33c}},
+			fp2elt{fpelt{0xeee3d7aa14025bdc, 0x08735e5922059344}, fpelt{0x0ce74f5c30ae108f, 0x074874a90748426e}},
+			fp2elt{fpelt{0xb8942f59c47fb700, 0x11ae324f37358ba5}, fpelt{0xdc6c0f0a505341ae, 0x3803eac1539cb271}},
+		},
+		{
+			fp2elt{fpelt{0xa9c0cf248f194953, 0x76fe9a846ae213cb}, fpelt{0xb6586e4540c99cc5, 0x15fe182b11b2e3ab}},
+			fp2elt{fpelt{0xc03ff3bf27ca658d, 0x7251c1135cfb4d4b}, fpelt{0x2885006c9d26c47d, 0x144a1c430ffc5d6b}},
+			fp2elt{fpelt{0xb391b8757950b197, 0x70dfcaadf3eeea1a}, fpelt{0x15343fd89b55b56f, 0x47d1d1e678ae5cbe}},
+			fp2elt{fpelt{0xeb47294b3859997c, 0x0180e8ada6b6638a}, fpelt{0xc806b1af6ad96fda, 0x25d3cd548509ec65}},
+		},
+		{
+			fp2elt{fpelt{0x3d36771b4e3ceadf, 0x7e2fcf0f712fd6bb}, fpelt{0x2e18d96b55d34260, 0x73cc76d768504108}},
+			fp2elt{fpelt{0xf011ae27043e3bfe, 0x26eb2b2fdcad4084}, fpelt{0xa2de319e48d4a1da, 0x1aa6d2254b588994}},
+			fp2elt{fpelt{0x24f910d07f0e1210, 0x18ef8b1c12b1ba39}, fpelt{0x0689e026c0633368, 0x1b7902276a598311}},
+			fp2elt{fpelt{0xca95e9a5dafd00a1, 0x014a5d4dd650fa53}, fpelt{0x380c1faee5e99662, 0x441f852d9913199a}},
+		},
+		{
+			fp2elt{fpelt{0xe0eeda9ee928a9cd, 0x60b956f54d97b13d}, fpelt{0x6436fdc476b43ce7, 0x2647cb4c71b209cc}},
+			fp2elt{fpelt{0x496b53ea26f3862e, 0x34a56037a83cc6e8}, fpelt{0x1bfaa5eb3d92ef52, 0x0e432d2733aa5082}},
+			fp2elt{fpelt{0xb89fc3b2d4521b8f, 0x7f0f51d154f0cf42}, fpelt{0xe083bcc608c6d2cc, 0x2216c43324c386a9}},
+			fp2elt{fpelt{0x7ebb964a971862ce, 0x1b35caddbbcc04d7}, fpelt{0x7dae63a625eed26e, 0x7c3d1b40982eb6f0}},
+		},
+		{
+			fp2elt{fpelt{0x2b5413148ad2f6b8, 0x4c0002fdff0e1143}, fpelt{0x4c8a4eb501705d8d, 0x39a5405443009eca}},
+			fp2elt{fpelt{0x3224b83827b06366, 0x2e33e9f05625e88a}, fpelt{0x7b3ebab12385f6d2, 0x59718916363d3719}},
+			fp2elt{fpelt{0x3fe4a8cf1f84d220, 0x6ee7b75e7ee1e5ca}, fpelt{0x61df9cdf051133b7, 0x3bff34c3c89a8ad3}},
+			fp2elt{fpelt{0xa51ec8d8e24d079a, 0x1b84c6180a6ecc93}, fpelt{0x65822e987a85e570, 0x74936064102b1d5a}},
+		},
+		{
+			fp2elt{fpelt{0x046de9f11aad3dbc, 0x43e5269a30fc053a}, fpelt{0x8f9450409a8b1e44, 0x649889e7a58f673a}},
+			fp2elt{fpelt{0x99ccb4fc84b08ff1, 0x7b6b667b74ccaf85}, fpelt{0x60d94f5fdcb914b5, 0x3c4fec05e12a4698}},
+			fp2elt{fpelt{0x4550afdf05557de0, 0x75d2fa2b17ec75ff}, fpelt{0x1ed1dedf201a7936, 0x6533a6e11d0fe649}},
+			fp2elt{fpelt{0x7aa838d793247f4c, 0x3b2c1912fff7401e}, fpelt{0x1ad6d7e6b2fd62d3, 0x4d886fc676e727a8}},
+		},
+		{
+			fp2elt{fpelt{0x806d2b1cb588f0bf, 0x536cbcb7a191aff0}, fpelt{0x382f127eed5e9100, 0x780529fe34509bab}},
+			fp2elt{fpelt{0x62f0f056e334e1bf, 0x22650787635aebd0}, fpelt{0x655d9dfa7d002b74, 0x01454de3e802ec7f}},
+			fp2elt{fpelt{0xf6e2fe46ce72cfed, 0x1a08a45f4f6d0ffc}, fpelt{0x9222be340c9bb2b4, 0x783637fc1d62bb76}},
+			fp2elt{fpelt{0x92a9771e614c10ae, 0x32024e9767482c86}, fpelt{0x35e8683522e5b195, 0x068f0ee19e64c8f8}},
+		},
+		{
+			fp2elt{fpelt{0x5f655b378987b7bd, 0x26e1f27f2f3e4955}, fpelt{0xb6bc90cf2c6d4ae6, 0x1df8c00510fef153}},
+			fp2elt{fpelt{0x8a41ed3a71f021e2, 0x2269a8238557a796}, fpelt{0x126d7b34a888f4f8, 0x6010259ce128afbc}},
+			fp2elt{fpelt{0xb44f5ead205e946d, 0x6ff3ed2c09641a94}, fpelt{0x6ebdb8004c801073, 0x35f4d77a7a350a54}},
+			fp2elt{fpelt{0x5c1f390276ed96ae, 0x7822849bd9b7b409}, fpelt{0x8abf66d4d67767cd, 0x1d1baf843e0f254f}},
+		},
+		{
+			fp2elt{fpelt{0x2003abbf007e309a, 0x45e1af858a92c5dd}, fpelt{0x7e5e2816acce3234, 0x2577a4602a918dab}},
+			fp2elt{fpelt{0x63c511eb04e3300a, 0x41a5c941734b0a14}, fpelt{0x7e7ed7512dbd83bb, 0x6b78158310bbda88}},
+			fp2elt{fpelt{0x89024460ac9d5b0e, 0x4183a470df3c2f33}, fpelt{0xaebbcd2935b09d65, 0x66129e13a126f4d4}},
+			fp2elt{fpelt{0xa08f9e936124e997, 0x19636208a91b0083}, fpelt{0xb4e9a2d0e2d792a1, 0x1d6b66c8adc41eda}},
+		},
+		{
+			fp2elt{fpelt{0xd6031149c1eea4bf, 0x44c0b04235e6d5c8}, fpelt{0x4ac122823251ded9, 0x3e4d58dc10354b3f}},
+			fp2elt{fpelt{0x1068e8d5ba198f1e, 0x161132f8f1f1fa26}, fpelt{0x657f245ebe3116b5, 0x58023539e780d666}},
+			fp2elt{fpelt{0x75af33a6457852f1, 0x3104ebc817b0f425}, fpelt{0x1d76fa4e27320445, 0x5981d33e01c37a72}},
+			fp2elt{fpelt{0x969c1ca18f47b082, 0x75d06ba4f5090f1e}, fpelt{0xf29ee562c0123590, 0x11f893877d602152}},
+		},
+		{
+			fp2elt{fpelt{0x78afc874aeeb139d, 0x55e831dd41924eff}, fpelt{0x840097d9a6966542, 0x26024070675f1602}},
+			fp2elt{fpelt{0xf79f3d36cc1ffdf4, 0x5e9774a5d106e29f}, fpelt{0x32d39cd43a8d02d2, 0x240a00704231d34f}},
+			fp2elt{fpelt{0xd8a6af23d78be8e0, 0x2dfcdc9f4dc44e25}, fpelt{0x48a3b406e0cf81dc, 0x522d07a254bb41db}},
+			fp2elt{fpelt{0x92c26e5815983d35, 0x052f12e80b8dcee5}, fpelt{0x12e44641877613d0, 0x49488c7534279f99}},
+		},
+		{
+			fp2elt{fpelt{0x603a3dc4c0524d90, 0x010560e82d7f2512}, fpelt{0xf3559624836bf2e5, 0x394cb61f41415309}},
+			fp2elt{fpelt{0x5e509fe1c7057c68, 0x4004e9e0ca0f8f5d}, fpelt{0x474c1dd440c7cb5b, 0x7050cf468cc8d7d8}},
+			fp2elt{fpelt{0x80af4cdaa6258f97, 0x5a6fc255c56cf9b0}, fpelt{0xb28505331206460c, 0x034803336bcf8f4f}},
+			fp2elt{fpelt{0x1a54405737fb084b, 0x6023ba396350d1a4}, fpelt{0xb48019c9654b0e8e, 0x59d569900081b9b7}},
+		},
+		{
+			fp2elt{fpelt{0x207f3a55629d4904, 0x0400821c2c0ccfbc}, fpelt{0xbbe7ec951ef933f9, 0x7f1dbee47caca1bc}},
+			fp2elt{fpelt{0x0086267bbbcaeb8e, 0x0ba7d1644d4b3e2e}, fpelt{0x9ff96d5559b5a26a, 0x1029e36a6f7a2419}},
+			fp2elt{fpelt{0x362ee33b5d173c93, 0x0182b191340a2517}, fpelt{0xd0ddabe7339da202, 0x5227f16ad75c1f5a}},
+			fp2elt{fpelt{0x51ed55e4b9bdcff6, 0x4eafec181354698b}, fpelt{0x11d321cc8b492d13, 0x5cc6a129efc38047}},
+		},
+		{
+			fp2elt{fpelt{0x2a817fd0af5e1d16, 0x151fff4a445ef9e5}, fpelt{0xd27c2f6f9bb137d2, 0x3b9f2fc702e95474}},
+			fp2elt{fpelt{0xa405512269e3df6e, 0x2c035deb505873d1}, fpelt{0xf7efe5e3d8304739, 0x5a398738e8cf183e}},
+			fp2elt{fpelt{0x6b15e35242013a0f, 0x481eed4d2602797c}, fpelt{0x47aa00fde79b594c, 0x6c828ad545ad70f8}},
+			fp2elt{fpelt{0x4635c116119a77a8, 0x1decdf32d644caab}, fpelt{0xfbcc956649d1c2aa, 0x404eb2d81a80778f}},
+		},
+		{
+			fp2elt{fpelt{0x808a1d33d03f7a82, 0x4251839fb867605d}, fpelt{0x9c98b2cf1796ab7f, 0x37226fa90599e005}},
+			fp2elt{fpelt{0xe8884c196f1c43aa, 0x64504409af03399d}, fpelt{0xccee572c019fc1fc, 0x0d08c503b20fe19c}},
+			fp2elt{fpelt{0x2c4075fefd81a300, 0x21d16ef45dae05fd}, fpelt{0x9b8349b790a5ad55, 0x791c906ab16e06bc}},
+			fp2elt{fpelt{0x7fe9f30fdce5f924, 0x1e8226a04f2f1d42}, fpelt{0xb110629b7f7a3c11, 0x0227f27b36b3d290}},
+		},
+		{
+			fp2elt{fpelt{0x8919b95fa9ae9a00, 0x5944196ff4d5cc3b}, fpelt{0x1bb8884db245330e, 0x1018a117a0fc2ee6}},
+			fp2elt{fpelt{0xd9edfb0279848651, 0x2a996d43705db3fc}, fpelt{0xf8f959f369ae322c, 0x046beb8d30f5717a}},
+			fp2elt{fpelt{0x7a8749a29f01e273, 0x0545e21e6da9bce9}, fpelt{0xdc360e0b096ee25e, 0x7e3c399742892ddb}},
+			fp2elt{fpelt{0x5e9ea5c960ae039f, 0x58d73aa4f1721e89}, fpelt{0x0f6f9cb05b1c46e3, 0x1335057c996f8415}},
+		},
+		{
+			fp2elt{fpelt{0x0773e931ccaa02e6, 0x00678288da7ad88a}, fpelt{0xc23f8c568a915ffa, 0x4ed689770e72af05}},
+			fp2elt{fpelt{0x7f48cb6c6a6e760b, 0x154c64f290cfff87}, fpelt{0x0e7d90003b609d03, 0x1e735939bd36356c}},
+			fp2elt{fpelt{0xbc9066a4b5d00dc9, 0x4db49d5adf683c60}, fpelt{0xf86b162f9f19ab66, 0x466024b66c6d46e7}},
+			fp2elt{fpelt{0x5f77d177385cd29c, 0x02cdbb0b9cb679b1}, fpelt{0x070637c2b709313e, 0x507d9c8d2df8a892}},
+		},
+		{
+			fp2elt{fpelt{0x1bc0ead6c99148d1, 0x0d2f86af0e747561}, fpelt{0x1793484669b1bb69, 0x1c0430a4773a1eda}},
+			fp2elt{fpelt{0x141e0bf8a6ba70a6, 0x770329280b8fff90}, fpelt{0xb1e6418335e6aa1d, 0x00853451db3d7509}},
+			fp2elt{fpelt{0x8e5029b287491ac4, 0x0b93c967346d1a3e}, fpelt{0x22fed485cbeae507, 0x13bbef37eeb04085}},
+			fp2elt{fpelt{0xa6d3ba9960f20797, 0x0a3dfdfb29634112}, fpelt{0x18b6dd51caef8ac6, 0x08ee7c66d8ef57cb}},
+		},
+		{
+			fp2elt{fpelt{0xd12ca7f7a329da6f, 0x4e11ba020f079a46}, fpelt{0xcecabefb7a5b2a8b, 0x2e3a6a94efbeb605}},
+			fp2elt{fpelt{0x9e7b1656a7ac28ad, 0x3ed6df73ca95ed8a}, fpelt{0xc91d4e22cecf3d64, 0x4538526831730b6b}},
+			fp2elt{fpelt{0xb23a273c3e174255, 0x5d674983b55fe4e0}, fpelt{0xd742f90bbbee7c59, 0x153b8f91e0c86e7c}},
+			fp2elt{fpelt{0x63b4a1397927fc51, 0x0dc06f81c0cfe3a9}, fpelt{0x433cccfd690ce0b0, 0x63601530a702f421}},
+		},
+		{
+			fp2elt{fpelt{0xe4ce8837bad9a920, 0x7408ed817354a50a}, fpelt{0x4ad4ab5c5dd38cd5, 0x003fb4423517980b}},
+			fp2elt{fpelt{0x045ff05c68bd6cc2, 0x1e004531a2bb089d}, fpelt{0x474da3d11caae636, 0x659a600c3c6aa6d4}},
+			fp2elt{fpelt{0x2ecc5befaa782af0, 0x4dc910e48bba84c3}, fpelt{0x9f5c6570eef99ed1, 0x12e4b0f6ca4b278b}},
+			fp2elt{fpelt{0xbf893c175c373716, 0x1b880fa005d92f05}, fpelt{0x7fe6596d948cbc26, 0x7d2b7e04859f07e9}},
+		},
+		{
+			fp2elt{fpelt{0x566939dcb2f5207e, 0x7e286e05b1426c73}, fpelt{0x33802b95d5781f09, 0x0640e988498f8dc1}},
+			fp2elt{fpelt{0xcc4e6b5f97bd8b7f, 0x3f60bae53c584dc1}, fpelt{0xddf8e03ce60b744f, 0x599402af439b4f77}},
+			fp2elt{fpelt{0xc1a48e3b2ec760b6, 0x79de135fb6f30827}, fpelt{0x9cb04c93a189cf42, 0x2968415d45c9009d}},
+			fp2elt{fpelt{0xedd54984465d25bd, 0x0b3fee177d022e5d}, fpelt{0x9031752399fb8326, 0x2c3cc3b6a8abb48c}},
+		},
+		{
+			fp2elt{fpelt{0xa2a167bf5bd0da74, 0x5109028889ae5193}, fpelt{0x1c3f912a0c9c2af3, 0x6a21eb53d4d3832a}},
+			fp2elt{fpelt{0xb1a04c1b8f386549, 0x07701663ed2a2e9e}, fpelt{0x8eef20937ffc1ba9, 0x66651de68cb0f6c7}},
+			fp2elt{fpelt{0x9c0752e51279933f, 0x4c5743997461b8e3}, fpelt{0x3d9211fa8c9e75f3, 0x25644d8769ea0ba7}},
+			fp2elt{fpelt{0xf178e30ff1731895, 0x514aebad980e76ae}, fpelt{0x220e878b99d8a88f, 0x6b9560c140b85e6b}},
+		},
+		{
+			fp2elt{fpelt{0x254007ada719f1cf, 0x4bff8c76ab8116c2}, fpelt{0xdf425ccfd699948a, 0x4a9db535be8d1e23}},
+			fp2elt{fpelt{0xf1f564585cd0f0ba, 0x630c4b54b106cd0c}, fpelt{0xaf2d09670de9a3bd, 0x3e31657d64b2c28a}},
+			fp2elt{fpelt{0xd5f27615792e041c, 0x1e088f4784684374}, fpelt{0x2344c51fb1ca8d5e, 0x6666ee0bf6a1d6ff}},
+			fp2elt{fpelt{0xe3c2027f1af469cc, 0x7e2b3c1dea391c47}, fpelt{0x613180dccd4c7ef8, 0x711e8559ba71eba6}},
+		},
+		{
+			fp2elt{fpelt{0xcbacff1d04fd1128, 0x0925a70be9e039b2}, fpelt{0x34d1cebf418be4ee, 0x2f24f0c56f8bccd8}},
+			fp2elt{fpelt{0x200496449e1efefc, 0x5140db0c3cba0d40}, fpelt{0xb4f46df9125659cc, 0x59cabd5edabd1555}},
+			fp2elt{fpelt{0x4df6e3f3311022a6, 0x3b4eddea376d0a0e}, fpelt{0xb60dcb5a8ede3d82, 0x0f3d08b4692d6083}},
+			fp2elt{fpelt{0x7ff082d7363d1ea3, 0x00c124789529878f}, fpelt{0xcb68ac960521b987, 0x1a82be7cd149f28f}},
+		},
+		{
+			fp2elt{fpelt{0x1402f803155f4174, 0x4ccd540a7bbe8c46}, fpelt{0x1b4d7391002802ad, 0x081e4b42605a8ff5}},
+			fp2elt{fpelt{0xf6663c196a1d7a73, 0x2c50607dd1dcab98}, fpelt{0xdf834e0a97f4cee0, 0x1faf170302c53b07}},
+			fp2elt{fpelt{0xbf9606d637758628, 0x4d933b31f276ae35}, fpelt{0x443f3750a3e426aa, 0x10f6b1d905a91622}},
+			fp2elt{fpelt{0xb4bfbf416ee851a5, 0x173be4e955b84cda}, fpelt{0xd7e6397a1f044029, 0x146611fa9caebd8d}},
+		},
+		{
+			fp2elt{fpelt{0xaa19ff335edd241b, 0x333924c75342e496}, fpelt{0xbf65a999be6f6234, 0x4c196a506e78418b}},
+			fp2elt{fpelt{0xe523af11b5c4ff3f, 0x5cb18392fd5e9f4c}, fpelt{0x3f54c32fdea6356b, 0x401404b181d9d463}},
+			fp2elt{fpelt{0x3975f1d7a72c83ab, 0x640bce839cfeb973}, fpelt{0xe795428de8c3ffc3, 0x6c6f5d6b12fff36b}},
+			fp2elt{fpelt{0xdb4e11e919e20a26, 0x1020775c2f392611}, fpelt{0x7b66bbfe029e9f44, 0x7fcdca2b030a9397}},
+		},
+		{
+			fp2elt{fpelt{0xc2b2aed4703607a2, 0x2ae77b80c6cd80c4}, fpelt{0x5e2b2e1137243e26, 0x59381d0ee85a2b8d}},
+			fp2elt{fpelt{0xc49c607f8e2b38f5, 0x1fb1e51ee5a4d575}, fpelt{0x9d49808e49cf2d28, 0x7ab0a7854bf26d33}},
+			fp2elt{fpelt{0x0e1e039a730d6dad, 0x4e450c8ec4121563}, fpelt{0x5dc396408229aaba, 0x14e8c0271ccf62a2}},
+			fp2elt{fpelt{0xbdee478e054f415c, 0x18f9c93738dc3e0b}, fpelt{0x23f9c02f1f1ae74b, 0x2d8034e41a01b8dc}},
+		},
+		{
+			fp2elt{fpelt{0x28f75b60c9c50ab7, 0x2b11e744651e645e}, fpelt{0x912eecce3ac90f26, 0x2f685d2780f199a1}},
+			fp2elt{fpelt{0xe50fd1f7ead19daa, 0x02896a92611c814f}, fpelt{0xa2d88bd349f07ed1, 0x1588936d6535115d}},
+			fp2elt{fpelt{0x3d40be16438dbc09, 0x76729688cc719c05}, fpelt{0x87b8d840336d6cdc, 0x284a6ce50d676e28}},
+			fp2elt{fpelt{0x83a6f5c3b03e601b, 0x0cb02181d33e089a}, fpelt{0xaccdddc24077017d, 0x4d8f1e91b63a6b34}},
+		},
+		{
+			fp2elt{fpelt{0xa2e77e4b41cab447, 0x5a1b1530f6b676d2}, fpelt{0xce5818d0903d39fa, 0x1fbc83eda55f25cd}},
+			fp2elt{fpelt{0x562486505ae337a8, 0x54a3915e06928f39}, fpelt{0x39d4c65257a40912, 0x17b68d9e409e4402}},
+			fp2elt{fpelt{0x336b72f5e43490ba, 0x12a5d27f752c20da}, fpelt{0x45489a29bf186850, 0x65fc633507e2b853}},
+			fp2elt{fpelt{0x7b66c778a9b02a4f, 0x48ef6b59bc21c07e}, fpelt{0x4445ee706b29c546, 0x36875349223c6fc4}},
+		},
+		{
+			fp2elt{fpelt{0xf87dcc1c972422d7, 0x0b0d6379c04c889a}, fpelt{0x7acc6ae3fc479fe1, 0x08c9dd1057c801ad}},
+			fp2elt{fpelt{0x748831d0236c9f71, 0x63f82c4b620e5890}, fpelt{0xabf4ba668e1b6151, 0x3e8802c18e80d489}},
+			fp2elt{fpelt{0x30e37f4786e12f38, 0x4d41aa0ab85e31a8}, fpelt{0x9b468dd969217566, 0x2e0da17b910b21e6}},
+			fp2elt{fpelt{0x3658a4b327a16068, 0x6aaa614a13f24948}, fpelt{0x3c8ab3a15a0a6cb3, 0x27828123dc654f4d}},
+		},
+		{
+			fp2elt{fpelt{0x4b7fdfdd546aee75, 0x79f8cb4fa3b15906}, fpelt{0x5dc076f3353b38cb, 0x6631360b5ae47871}},
+			fp2elt{fpelt{0xf9558a9a9c66bc5b, 0x12d1378d8aa2a01c}, fpelt{0xe06d092016fb5471, 0x7f83d234667b2c64}},
+			fp2elt{fpelt{0x65b0ac0fa9f696df, 0x2e1dc4dc7acd0eea}, fpelt{0xd71d52f0e4997020, 0x53e50b007ec5942e}},
+			fp2elt{fpelt{0x28a528da5541a1a7, 0x7f7a556e3d0b6fb8}, fpelt{0x1c37b33ed8916207, 0x0f7c13f121294b55}},
+		},
+		{
+			fp2elt{fpelt{0x8a7b0269b2a20127, 0x2a7a018cda51e7bf}, fpelt{0x8246e751d2b1aeb2, 0x218e076c082be881}},
+			fp2elt{fpelt{0x7c687672602f9262, 0x5d5cc74e15508e35}, fpelt{0x3dcd3b5152ee3bcc, 0x50758190bb36b87e}},
+			fp2elt{fpelt{0xdcd8007770828c2e, 0x4879d13302190eda}, fpelt{0xd39e1612b0e27e9f, 0x3f401773c4ffe4c2}},
+			fp2elt{fpelt{0x2f0d691bac082b12, 0x752dea7f39a4b926}, fpelt{0xa57f6695f554cd9c, 0x03adca2620af3a92}},
+		},
+		{
+			fp2elt{fpelt{0x07e9552bede63556, 0x6b8ca80f8187b288}, fpelt{0xd72e5c0efa53b603, 0x35cd6aa884de3701}},
+			fp2elt{fpelt{0x1b89cacfc87a2839, 0x1995840480c2f4fe}, fpelt{0x7d62d278fabc292e, 0x0b05c654eef43cf5}},
+			fp2elt{fpelt{0xddfb627de47e76c5, 0x37f5a314eec2b87b}, fpelt{0x3f8fb725840a7cf5, 0x7e9e08c2ab60778e}},
+			fp2elt{fpelt{0x3e192294b156bcb9, 0x47f8bb0489f4b0cc}, fpelt{0x898fd544efa0f77a, 0x15e262a8ae671c5b}},
+		},
+		{
+			fp2elt{fpelt{0x6136194002838721, 0x0f070a1540deb782}, fpelt{0xe285923283f90e28, 0x29b5d3491aa53761}},
+			fp2elt{fpelt{0x45ba6877fbb17363, 0x548c5fa2167e89bf}, fpelt{0x7c893dbd21d1079b, 0x009e2bd66bf40abd}},
+			fp2elt{fpelt{0x7859a992ff253487, 0x116bd8f88c4c667e}, fpelt{0xdbff631a8bfedf0b, 0x3d2e2d2af1d9cc0a}},
+			fp2elt{fpelt{0xb9320fd8aebf21fd, 0x0eea762ca39fc8cf}, fpelt{0xfa2db25b48a6b214, 0x1de83c5575c48beb}},
+		},
+		{
+			fp2elt{fpelt{0x1a226431dcb02c2c, 0x1f513047a7ad319d}, fpelt{0x3dbcc9d7d4fd37e2, 0x59ceec63cd718532}},
+			fp2elt{fpelt{0x4ef7865aee08b3f8, 0x6d0f5c8ac9164817}, fpelt{0x5db81c8ac798c19c, 0x6605d00ee904f19d}},
+			fp2elt{fpelt{0x26f56ce639c55ee3, 0x1a92c74f9a1f219e}, fpelt{0xc0164768c9601a88, 0x7845013c17a8d06e}},
+			fp2elt{fpelt{0xa4c9fb7d93255266, 0x7ddf42c3081e2838}, fpelt{0xc91cbee3c4ec44c5, 0x436bce573d334a87}},
+		},
+		{
+			fp2elt{fpelt{0xe75eaeaea9e4bd63, 0x429703767c517a4e}, fpelt{0x1da8ec7225afdb80, 0x5e6e7e44afaa575f}},
+			fp2elt{fpelt{0x625103aec9044b5c, 0x1d8703b87ae6f012}, fpelt{0x2348e28a5484ffa6, 0x094ab66ed4baaa5c}},
+			fp2elt{fpelt{0x8964cefdff76cbc9, 0x2158fc5f783b042c}, fpelt{0x6b03063b1b36b33e, 0x14b8227468ed15ea}},
+			fp2elt{fpelt{0x9f238b131818bf8e, 0x4e3c61c646ff74cd}, fpelt{0x75e152b81a393286, 0x460793d4dbef17a7}},
+		},
+		{
+			fp2elt{fpelt{0xa9d19c44519e7a44, 0x382d04a4e02b52e9}, fpelt{0x608e55c9e9a58edb, 0x2b1a3b3f48fb7021}},
+			fp2elt{fpelt{0x8da51cc1163286de, 0x03790b576e2579ee}, fpelt{0x992fc6a525a0643c, 0x72f0efa59d8993b8}},
+			fp2elt{fpelt{0x752379d404fc1878, 0x53958200e040c407}, fpelt{0xed6571ce34284548, 0x0d0b179dcaa25db0}},
+			fp2elt{fpelt{0xcc99e0b92ed21fa7, 0x157bae02e22261aa}, fpelt{0x3a4da4cd49ea5ff6, 0x55a4975b0228c11b}},
+		},
+		{
+			fp2elt{fpelt{0x3a3552409bb9d307, 0x4d9ad9269100756c}, fpelt{0x9706dafe6f928908, 0x3155287fb5150b6f}},
+			fp2elt{fpelt{0x0655da4a4044f5c9, 0x16e4b64b16b5556c}, fpelt{0xf6293e361611950e, 0x6548f76a262e1623}},
+			fp2elt{fpelt{0xfe1563f86cb9a789, 0x4d93afc215fc83eb}, fpelt{0x96c8139cda185b3e, 0x52fa4c047f9fdd8c}},
+			fp2elt{fpelt{0x256e866038d2422b, 0x21932f8eafbf4431}, fpelt{0xf4a960a43842ad87, 0x726a5e07cdd7bf82}},
+		},
+		{
+			fp2elt{fpelt{0xd7a28d5dc8975b4f, 0x3eb4bd7952034fb3}, fpelt{0xd37d29eb7d82e6b0, 0x2774aa1485857d65}},
+			fp2elt{fpelt{0xc8eaf40c2ae701b7, 0x6cf28999bb915957}, fpelt{0x79ad4e3ba3e7f1a0, 0x1a920e494016b053}},
+			fp2elt{fpelt{0x8c0e9e683b4b785a, 0x18b819edc5a006f2}, fpelt{0x2b423a6248729b28, 0x311e5c8d9b8f3ba6}},
+			fp2elt{fpelt{0xf8eaf28410afe4f6, 0x691c58cd1a4f3de0}, fpelt{0x029c99ba6e4f7023, 0x3f61884b25f80cb6}},
+		},
+		{
+			fp2elt{fpelt{0xd8e61bbbd7caf33e, 0x456ce6cd6e4e51f1}, fpelt{0xed98849bc8e7224c, 0x72f7e5f2616e6a32}},
+			fp2elt{fpelt{0x0130fd1816e5d9be, 0x65f5c871225c0390}, fpelt{0x63046301de885f7e, 0x1dc721332d223a28}},
+			fp2elt{fpelt{0xaa94c60cdc0fbaaa, 0x2475b06dd9f5e108}, fpelt{0x91581ef264ca52c7, 0x6a41ffbe70d61759}},
+			fp2elt{fpelt{0xa60aec7842cf03b6, 0x3d16ee06b49e7a62}, fpelt{0x9220cdb5d6cb1076, 0x0ea143008feedf7a}},
+		},
+		{
+			fp2elt{fpelt{0xefabfd99b074e672, 0x699238c518b837f4}, fpelt{0x184fe227546786c3, 0x6bc971fda3d9f3fc}},
+			fp2elt{fpelt{0xdf74b7033b4d0203, 0x7eae2d5dfb139c24}, fpelt{0x62bb2705b3e12094, 0x3cf6797e83208797}},
+			fp2elt{fpelt{0xfda8504cdafda8d9, 0x51c7bd9d30ad8fa6}, fpelt{0xd4fb1a230281859e, 0x0b5ad4a1282d0962}},
+			fp2elt{fpelt{0x91195389d5a42788, 0x789daa7ae0edf864}, fpelt{0x8f7de55ebb6434f1, 0x7c329188edd02099}},
+		},
+		{
+			fp2elt{fpelt{0xa5b628b6c1ed50d8, 0x0c6fb305c333212c}, fpelt{0x9a655bcd7f09ff86, 0x308af8fe04ef70db}},
+			fp2elt{fpelt{0x895ea47fbd03b50f, 0x7b89644941372cff}, fpelt{0x4ecf186f63716b60, 0x5bc84e624c3b7eac}},
+			fp2elt{fpelt{0x86c37019efbb036e, 0x65b1df2fda58657d}, fpelt{0xaa0570514003beca, 0x0b3d44d5ac965c01}},
+			fp2elt{fpelt{0x8fa906db54f0f00b, 0x17b2f0b5e5b540c4}, fpelt{0xfa13dbc80ac1046b, 0x6e9fccd396536273}},
+		},
+		{
+			fp2elt{fpelt{0x344c0e0628397705, 0x076b13ef13e4dcf8}, fpelt{0xb8d1917890c65a77, 0x4b922233f05cdc13}},
+			fp2elt{fpelt{0x75440477f487dc75, 0x5c4c69d1d2b9010e}, fpelt{0x076d4d56a92d8264, 0x4e24ac8d10322afc}},
+			fp2elt{fpelt{0xf7a85e6a6a4e2f94, 0x153e7f6ffc583fd1}, fpelt{0x150b9738bd372585, 0x4e26571672da3567}},
+			fp2elt{fpelt{0xee5e9c559efe8af7, 0x3d32950a5e1ac466}, fpelt{0xb8c9c5fb3d400285, 0x21672c4fd6f37b36}},
+		},
+		{
+			fp2elt{fpelt{0x66da97071af47e61, 0x6b2152dd81d5d34b}, fpelt{0xc82d47809e8388d2, 0x5a5541873361e973}},
+			fp2elt{fpelt{0xacf5b5e2919ab07a, 0x4683bc3b764c7683}, fpelt{0xd26b72fd1985fe3a, 0x58fa3f60bf645a2e}},
+			fp2elt{fpelt{0xecbaef3338dfd562, 0x717271f1fa05f96d}, fpelt{0x7ce30f9df6205ea4, 0x4d42ef7b685428a3}},
+			fp2elt{fpelt{0xe30c6ed77b287058, 0x7227d14e46ac8c27}, fpelt{0xa668f42cdfdf24be, 0x6a91b5eca1df9937}},
+		},
+		{
+			fp2elt{fpelt{0x7c6f935cd2ac7a40, 0x1a378b51cfe92da1}, fpelt{0xc9616c8e2fd16c7d, 0x4f896bb678f5b5f9}},
+			fp2elt{fpelt{0x8e67e9e8c659f78e, 0x4781cb98df18595b}, fpelt{0xf9461e06234cd259, 0x6b6188b15a4a8672}},
+			fp2elt{fpelt{0xb7e53eac507ec507, 0x48ce814ca0f01d1e}, fpelt{0x27757e14f34f2ee8, 0x44feff21f2f83849}},
+			fp2elt{fpelt{0x7b9fc16aa0b4c7de, 0x430928269fed28e6}, fpelt{0x3eaa9084a299c97f, 0x5e06f133b7559520}},
+		},
+		{
+			fp2elt{fpelt{0xe96d4d1dea639420, 0x0ff31d75f89c7f56}, fpelt{0x44b1f09572e64527, 0x43912900313b1fb9}},
+			fp2elt{fpelt{0x26f4afe16da00c46, 0x45214d242a57d934}, fpelt{0x0cef121b9a2c4594, 0x345dba2959f1f85f}},
+			fp2elt{fpelt{0x70b6a9ac1b41ef94, 0x57e31e592272ccd8}, fpelt{0x1ca1c32ff024098e, 0x2a81579694eff190}},
+			fp2elt{fpelt{0x2518049126f9a378, 0x1566d98d178d8df4}, fpelt{0xf97ba0f539b60630, 0x79a03efdddcaf9d1}},
+		},
+		{
+			fp2elt{fpelt{0x91a90f7226eb8116, 0x155c30636c4e9371}, fpelt{0x89ad2959d2258d68, 0x0cf28355ac824c75}},
+			fp2elt{fpelt{0xd7a168ab17109fc2, 0x3595edf82f7d483f}, fpelt{0xcc840ede93765b6e, 0x0354658a4ac9574b}},
+			fp2elt{fpelt{0x0dd6d5e544c1decf, 0x7fd0d36ec1b83cb7}, fpelt{0x92912ab8cb800e7a, 0x32023fd10165d3c6}},
+			fp2elt{fpelt{0x9750fb22c2a58293, 0x21200c493435c741}, fpelt{0xd6436b567b6eee5a, 0x3228371e38c4cf5e}},
+		},
+		{
+			fp2elt{fpelt{0x8abcea1fac863ed8, 0x13c795f4b684a05d}, fpelt{0x6719d77470f37e0e, 0x6d34cc0e56bbd13f}},
+			fp2elt{fpelt{0xc192b4186b128fe0, 0x59fcf7ed1d757d43}, fpelt{0x5b4db8d4b95093e4, 0x009f8e7249e67b0e}},
+			fp2elt{fpelt{0xfe6710f578790397, 0x417094de1effec4a}, fpelt{0x1464ca505e4d183b, 0x5bc2fd0ded402c2f}},
+			fp2elt{fpelt{0xb43e80df2d8f0c68, 0x515acb8cdd9ceaf1}, fpelt{0x5c52320e31c23f89, 0x4f99913644124cad}},
+		},
+		{
+			fp2elt{fpelt{0x66d6d441dd1589d3, 0x6daa050fe4dc80e8}, fpelt{0x0d608268165dd06f, 0x1219fff31c1369a6}},
+			fp2elt{fpelt{0x952ce42b56d675ce, 0x2fcbe8d12019a909}, fpelt{0xe09b4feba8bb4073, 0x1276b90df57d8167}},
+			fp2elt{fpelt{0xd0f3fb227837e5ac, 0x038d0826197508fb}, fpelt{0x6e815e6f5817ed4a, 0x5d6dfb23eb561255}},
+			fp2elt{fpelt{0x339b23402d114287, 0x0a7b4e099da7f8a3}, fpelt{0x1dfd9065e50521bd, 0x4f28eb37c6bf6b95}},
+		},
+		{
+			fp2elt{fpelt{0x695fa8fe96089190, 0x2dfdaea818f1ebe4}, fpelt{0xe2ca893ddce63a8a, 0x624a27b1267c5189}},
+			fp2elt{fpelt{0x58aa5883d984acd6, 0x207dcb60f29b2b16}, fpelt{0x5deb2266dbc46426, 0x1d9b2e6b89f2a5d3}},
+			fp2elt{fpelt{0xba4e69ad5bd87a58, 0x1d4843a2d4dbdba5}, fpelt{0xea0fb6805eaf9506, 0x750ea78034de02e3}},
+			fp2elt{fpelt{0x0c9b554d2a25a640, 0x0d67d4887076f28d}, fpelt{0x32d7842b2ecaa31e, 0x460d0fdee4801cb1}},
+		},
+		{
+			fp2elt{fpelt{0x2cf88596034e83c2, 0x3fa5f46d70c5ac21}, fpelt{0xed400b50f3678848, 0x616f7e6cd30d49f0}},
+			fp2elt{fpelt{0x439a2eb1c8027320, 0x5daeb901cb6178f7}, fpelt{0x567b64dfe7a590d2, 0x5b3712b28c346c4b}},
+			fp2elt{fpelt{0x2955a386306ba29d, 0x64e946809527f3ff}, fpelt{0xf102a2f93b62ea52, 0x6c1f3ff4c0d6097e}},
+			fp2elt{fpelt{0xda4445c9d0694eba, 0x59bc6f933dbc6bdb}, fpelt{0x91202b638b5bf7ab, 0x74b14796e239732a}},
+		},
+		{
+			fp2elt{fpelt{0xb281c6f4ca6b88ee, 0x3936e16ee0b09978}, fpelt{0x082ca5d3f1f9300a, 0x2297522802d31ac1}},
+			fp2elt{fpelt{0x37828e4aa61ff042, 0x667c376470e440a4}, fpelt{0xae328e5815d7861c, 0x4bd104b131254e8c}},
+			fp2elt{fpelt{0x35926fc149c77ca1, 0x000b434ae2abe3d5}, fpelt{0x43bb881b0e4fc260, 0x4dd02e6d4daaa1ad}},
+			fp2elt{fpelt{0xf478c1b636bae989, 0x6c68c93d9936d5fb}, fpelt{0x82166cc7410a0f4b, 0x0e7c25ac880ec9d8}},
+		},
+		{
+			fp2elt{fpelt{0x98ff66a994df8d4e, 0x6098650123efbb43}, fpelt{0xccac8803648b892c, 0x2b3a2625f92df7c1}},
+			fp2elt{fpelt{0x843de0ed49f2ab9d, 0x730495948221dcff}, fpelt{0x37fbcb9d2d5c073b, 0x6db80ae86474d976}},
+			fp2elt{fpelt{0xf5a64aaf32d375c7, 0x7ec934593a34bf25}, fpelt{0x102cee2f8e241816, 0x5059226111196fcb}},
+			fp2elt{fpelt{0x94a9dae23af87271, 0x778ea1763033105b}, fpelt{0x245ec179d2e69432, 0x4b4c7397ac040e6d}},
+		},
+		{
+			fp2elt{fpelt{0xa4487f491302021e, 0x785a2c9a10ab9a78}, fpelt{0x679635643d29bdbb, 0x5ce09cf36c12a41e}},
+			fp2elt{fpelt{0x4805ae7a2b2bc870, 0x3c38a0565ce83e46}, fpelt{0xc71ce6ec9d00127d, 0x621c21d6609e5e3e}},
+			fp2elt{fpelt{0xfc9c9acfb5206218, 0x1f5045fc5222dcc3}, fpelt{0xde7dc0aaee3e9b56, 0x26709a9fa57a0c22}},
+			fp2elt{fpelt{0x3e6f36f2e41c71e0, 0x6d1c9c4dbf9307d0}, fpelt{0x60f975eacdb160ac, 0x7905eb14cde9cb49}},
+		},
+		{
+			fp2elt{fpelt{0x1f7175ed07354d4e, 0x6e93c01fb9b5d059}, fpelt{0xcd180c967124e004, 0x5969d63021f31dbf}},
+			fp2elt{fpelt{0x73b6eb1e79844463, 0x687606fb4f810cc5}, fpelt{0x4926ea1083adc37a, 0x1620f81a69a871ac}},
+			fp2elt{fpelt{0x55db56a64f32090d, 0x3254ab9bee6cc406}, fpelt{0x8c0ad1206750ba89, 0x3e177590b27e5bf0}},
+			fp2elt{fpelt{0xe2685c095f472a62, 0x159716739c667abf}, fpelt{0x96ad10c1e70e0884, 0x4cb236284355786d}},
+		},
+		{
+			fp2elt{fpelt{0x30154da33cccfb67, 0x17cac38b932ee0ff}, fpelt{0x139983064653c67d, 0x1b2a27b7aebce56e}},
+			fp2elt{fpelt{0xfb7c373c68667ae8, 0x77937357225335f0}, fpelt{0x42f5d98c58acd27f, 0x23f22f9846233647}},
+			fp2elt{fpelt{0x2f1cb34bbf1a12cd, 0x7c2e66f08a89b230}, fpelt{0xd3bb5f269a98c554, 0x239e07e7aa48f108}},
+			fp2elt{fpelt{0xc1075b3582d0cc71, 0x3f659e5da2f3a607}, fpelt{0xe7912119bd8254aa, 0x58a42f7f21e2a980}},
+		},
+		{
+			fp2elt{fpelt{0x251ddb81073e34fd, 0x694c0c8002df5617}, fpelt{0x937e2fb490fff45f, 0x2c1ee74511adda7b}},
+			fp2elt{fpelt{0x41296c683296e31a, 0x0a816867e444032a}, fpelt{0xcdb8c9ccabfe27af, 0x18bf97ecbdc1706d}},
+			fp2elt{fpelt{0x870875d1f473d7ce, 0x6fe26180fd72f2fa}, fpelt{0x875668f5c74c114d, 0x3fab15656d014437}},
+			fp2elt{fpelt{0x122d12885c61ca5c, 0x104b37ae9dee37ef}, fpelt{0x7cdf0e74f1de5e0b, 0x393ef4cf7ca6df9b}},
+		},
+		{
+			fp2elt{fpelt{0x28eb48c03df0a56e, 0x520f6be151a20626}, fpelt{0x3bd7290fb0dd9bcf, 0x5fe8cabb192b1f94}},
+			fp2elt{fpelt{0xbc053fb1aceb9437, 0x1b7f1ce5bba333df}, fpelt{0x32c490aae23904cc, 0x5f726de695d638f9}},
+			fp2elt{fpelt{0xea4d3989d18594aa, 0x79f5c94b5334ecdf}, fpelt{0xd9369aa4aa1f099a, 0x2fc71377ffad94f7}},
+			fp2elt{fpelt{0x430aa4c747d49934, 0x1e736559b9080f76}, fpelt{0xa6f57ac84e81e378, 0x42392f1c9c036a45}},
+		},
+		{
+			fp2elt{fpelt{0x34ef87d09c14e350, 0x665b2dcf6f89e9e7}, fpelt{0x78e755253153a270, 0x484a96d681ad10f7}},
+			fp2elt{fpelt{0x49c2cf954b671f53, 0x0ca83048ef43c4b0}, fpelt{0xb93da71ff91f1a88, 0x60eef611821a170d}},
+			fp2elt{fpelt{0x44201ea376b2bf8c, 0x29c9deca8ebfec5d}, fpelt{0xdc544c1ee71696ce, 0x1053e5560137e59e}},
+			fp2elt{fpelt{0x4d2d74737815e724, 0x3e144bd9f783fc70}, fpelt{0xcd091439fec1b170, 0x6467116bccc77d3c}},
+		},
+		{
+			fp2elt{fpelt{0xf83384378f86b01c, 0x174f9f2362f30322}, fpelt{0xd9cc6878caec64f9, 0x4c0b8a3908254291}},
+			fp2elt{fpelt{0x44b4aa5e3aa79190, 0x541014d3f8ef2f02}, fpelt{0xb0cbc889ea3cbcf5, 0x3d8047a7ee2774b9}},
+			fp2elt{fpelt{0x297727a147e3ba36, 0x7ce8e4a779a95082}, fpelt{0x8d911720b88caba2, 0x3ffbc9ca4c03cf29}},
+			fp2elt{fpelt{0xcf23b3d8888ab8fc, 0x0f2cd0394f267e52}, fpelt{0xddeb74959f4b6863, 0x16276e750df8e8f4}},
+		},
+		{
+			fp2elt{fpelt{0x00c4f5452a7dd880, 0x2a533d5a860617a7}, fpelt{0x9c5e9833d96628bf, 0x5e2843bf2e4c3599}},
+			fp2elt{fpelt{0x56f691410e5c63f5, 0x1da91bed14088196}, fpelt{0xed5d5f0fd5e06cec, 0x4fa3630fb258757a}},
+			fp2elt{fpelt{0xfa9a4a01337d07ad, 0x1af8be61d5b1802c}, fpelt{0x712622dfe139ed12, 0x0a763c24644c0de4}},
+			fp2elt{fpelt{0x8a87b28c9d29d72d, 0x5a4af608d312ec51}, fpelt{0xde34af293008590c, 0x47ba435f6fc88e61}},
+		},
+	}
+
+	smallOrderPoints = []affine{
+		{
+			fp2elt{fpelt{0x0000000000000000, 0x0000000000000000}, fpelt{0x0000000000000001, 0x0000000000000000}},
+			fp2elt{fpelt{0x0000000000000000, 0x0000000000000000}, fpelt{0x0000000000000000, 0x0000000000000000}},
+		},
+		{
+			fp2elt{fpelt{0x0000000000000000, 0x0000000000000000}, fpelt{0xfffffffffffffffe, 0x7fffffffffffffff}},
+			fp2elt{fpelt{0x0000000000000000, 0x0000000000000000}, fpelt{0x0000000000000000, 0x0000000000000000}},
+		},
+		{
+			fp2elt{fpelt{0x73539d779e85b789, 0x39e7e38582985bee}, fpelt{0x3d159709ccba172d, 0x05b50a0043b08610}},
+			fp2elt{fpelt{0x98c3c6dbe2d20a00, 0x5067b158049e89f5}, fpelt{0xa7789d7d70b97d33, 0x0176ab60c10abfa2}},
+		},
+		{
+			fp2elt{fpelt{0x8cac6288617a4876, 0x46181c7a7d67a411}, fpelt{0xc2ea68f63345e8d2, 0x7a4af5ffbc4f79ef}},
+			fp2elt{fpelt{0x98c3c6dbe2d20a00, 0x5067b158049e89f5}, fpelt{0xa7789d7d70b97d33, 0x0176ab60c10abfa2}},
+		},
+		{
+			fp2elt{fpelt{0x0000000000000000, 0x0000000000000000}, fpelt{0x0000000000000000, 0x0000000000000000}},
+			fp2elt{fpelt{0x0000000000000001, 0x0000000000000000}, fpelt{0x0000000000000000, 0x0000000000000000}},
+		},
+		{
+			fp2elt{fpelt{0x42bd91088b297763, 0x20225cfe6982bc71}, fpelt{0x9ff6f5c1f0f9c4e9, 0x63e977c83c3d6afc}},
+			fp2elt{fpelt{0x7487934b45a90e01, 0x13ebfea2f0593b9d}, fpelt{0xe36f4f77f9136cae, 0x5be29499adfb84be}},
+		},
+		{
+			fp2elt{fpelt{0xbd426ef774d6889c, 0x5fdda301967d438e}, fpelt{0x60090a3e0f063b16, 0x1c168837c3c29503}},
+			fp2elt{fpelt{0x7487934b45a90e01, 0x13ebfea2f0593b9d}, fpelt{0xe36f4f77f9136cae, 0x5be29499adfb84be}},
+		},
+		{
+			fp2elt{fpelt{0xb4231f9f4cf4cce5, 0x2e1ac5842be9bf48}, fpelt{0x2bf3375a533f9127, 0x18f7c36966869385}},
+			fp2elt{fpelt{0xa58a2837ccc43901, 0x771b341bb4dbee6a}, fpelt{0xa22b23f8af95c6a9, 0x14e744a7324700cf}},
+		},
+		{
+			fp2elt{fpelt{0x4bdce060b30b331a, 0x51e53a7bd41640b7}, fpelt{0xd40cc8a5acc06ed8, 0x67083c9699796c7a}},
+			fp2elt{fpelt{0xa58a2837ccc43901, 0x771b341bb4dbee6a}, fpelt{0xa22b23f8af95c6a9, 0x14e744a7324700cf}},
+		},
+		{
+			fp2elt{fpelt{0x79ad8ac9b83fcef3, 0x2f68673441b775e6}, fpelt{0x99078be54004b3ca, 0x40ce683c8948f1e8}},
+			fp2elt{fpelt{0xf672b649cb576b02, 0x0299cbc61a2b2a7b}, fpelt{0x1b54a8a86d1a54ca, 0x6cb54ba587dbf588}},
+		},
+		{
+			fp2elt{fpelt{0x8652753647c0310c, 0x509798cbbe488a19}, fpelt{0x66f8741abffb4c35, 0x3f3197c376b70e17}},
+			fp2elt{fpelt{0xf672b649cb576b02, 0x0299cbc61a2b2a7b}, fpelt{0x1b54a8a86d1a54ca, 0x6cb54ba587dbf588}},
+		},
+		{
+			fp2elt{fpelt{0x6c9d5405f6b01e72, 0x19b4cee07aa345ea}, fpelt{0x2f88ac35b9941f5a, 0x7d678502d1998b7b}},
+			fp2elt{fpelt{0xe540eb7198aa5604, 0x50694f379cab4551}, fpelt{0xc86d772fb909b331, 0x5de64346ae576dee}},
+		},
+		{
+			fp2elt{fpelt{0x9362abfa094fe18d, 0x664b311f855cba15}, fpelt{0xd07753ca466be0a5, 0x02987afd2e667484}},
+			fp2elt{fpelt{0xe540eb7198aa5604, 0x50694f379cab4551}, fpelt{0xc86d772fb909b331, 0x5de64346ae576dee}},
+		},
+		{
+			fp2elt{fpelt{0xd54baa2ffb5ebf90, 0x0f2e6cbac6991d1d}, fpelt{0x2e17d9306533895b, 0x105db478b5999e91}},
+			fp2elt{fpelt{0xff550d277578aa04, 0x1ac721558ee96776}, fpelt{0xb6c2099544e20ea7, 0x234c744272683e29}},
+		},
+		{
+			fp2elt{fpelt{0x2ab455d004a1406f, 0x70d193453966e2e2}, fpelt{0xd1e826cf9acc76a4, 0x6fa24b874a66616e}},
+			fp2elt{fpelt{0xff550d277578aa04, 0x1ac721558ee96776}, fpelt{0xb6c2099544e20ea7, 0x234c744272683e29}},
+		},
+		{
+			fp2elt{fpelt{0x68a528d86b78e627, 0x374c3f6b6c3ee4d0}, fpelt{0x1ba566106a8ff977, 0x7ea2edd061ec3b55}},
+			fp2elt{fpelt{0x5d45d463a6288f07, 0x48967a023b9b4c2b}, fpelt{0x3d7b93050fc4369b, 0x159db63c49e4415b}},
+		},
+		{
+			fp2elt{fpelt{0x975ad727948719d8, 0x48b3c09493c11b2f}, fpelt{0xe45a99ef95700688, 0x015d122f9e13c4aa}},
+			fp2elt{fpelt{0x5d45d463a6288f07, 0x48967a023b9b4c2b}, fpelt{0x3d7b93050fc4369b, 0x159db63c49e4415b}},
+		},
+		{
+			fp2elt{fpelt{0xabcc4297dd94f468, 0x0e5b75da50d1797a}, fpelt{0x9f375a75fce46fb6, 0x2c7972da1d1fcf39}},
+			fp2elt{fpelt{0x7edc00dd8f84590a, 0x08aa53ac1b2c0456}, fpelt{0xe3e6e04b079e10bf, 0x05eeb114acd4461b}},
+		},
+		{
+			fp2elt{fpelt{0x5433bd68226b0b97, 0x71a48a25af2e8685}, fpelt{0x60c8a58a031b9049, 0x53868d25e2e030c6}},
+			fp2elt{fpelt{0x7edc00dd8f84590a, 0x08aa53ac1b2c0456}, fpelt{0xe3e6e04b079e10bf, 0x05eeb114acd4461b}},
+		},
+		{
+			fp2elt{fpelt{0x852c8e2369d14771, 0x14d376576651c5d6}, fpelt{0x80a8e783bb6ae641, 0x04b4e4a7c29fc786}},
+			fp2elt{fpelt{0xd34c7be829bb6c0a, 0x62130f8004478a16}, fpelt{0x94a7ad614005b358, 0x04b823d6d3577a50}},
+		},
+		{
+			fp2elt{fpelt{0x7ad371dc962eb88e, 0x6b2c89a899ae3a29}, fpelt{0x7f57187c449519be, 0x7b4b1b583d603879}},
+			fp2elt{fpelt{0xd34c7be829bb6c0a, 0x62130f8004478a16}, fpelt{0x94a7ad614005b358, 0x04b823d6d3577a50}},
+		},
+		{
+			fp2elt{fpelt{0x2b60907f8fa20845, 0x1fdb593811887b26}, fpelt{0xe3402a33c7221e24, 0x0a19c84c0affa311}},
+			fp2elt{fpelt{0x112cf149dde6980a, 0x5f7bfa24a6b8f2a9}, fpelt{0x0a4e2b3992ef3bd9, 0x3a7a98c0ead3a1a0}},
+		},
+		{
+			fp2elt{fpelt{0xd49f6f80705df7ba, 0x6024a6c7ee7784d9}, fpelt{0x1cbfd5cc38dde1db, 0x75e637b3f5005cee}},
+			fp2elt{fpelt{0x112cf149dde6980a, 0x5f7bfa24a6b8f2a9}, fpelt{0x0a4e2b3992ef3bd9, 0x3a7a98c0ead3a1a0}},
+		},
+		{
+			fp2elt{fpelt{0x5ccb78db60ff4f00, 0x1777b96609c94057}, fpelt{0x333ac5403acd613b, 0x4111f00c69884394}},
+			fp2elt{fpelt{0x245be2637363f50a, 0x58a8f06591d94606}, fpelt{0x9b7935ede21e7e6d, 0x14b69b2eaf07ba0d}},
+		},
+		{
+			fp2elt{fpelt{0xa33487249f00b0ff, 0x68884699f636bfa8}, fpelt{0xccc53abfc5329ec4, 0x3eee0ff39677bc6b}},
+			fp2elt{fpelt{0x245be2637363f50a, 0x58a8f06591d94606}, fpelt{0x9b7935ede21e7e6d, 0x14b69b2eaf07ba0d}},
+		},
+		{
+			fp2elt{fpelt{0xac5c2edceae36a80, 0x14a55eab61730ce3}, fpelt{0xb9cb12bbce7e4629, 0x57f74a5631809964}},
+			fp2elt{fpelt{0xa3d29eaf6e48830d, 0x0a48b93dde2c79c9}, fpelt{0x3a8312c08793c455, 0x760cdf15b84cd4dd}},
+		},
+		{
+			fp2elt{fpelt{0x53a3d123151c957f, 0x6b5aa1549e8cf31c}, fpelt{0x4634ed443181b9d6, 0x2808b5a9ce7f669b}},
+			fp2elt{fpelt{0xa3d29eaf6e48830d, 0x0a48b93dde2c79c9}, fpelt{0x3a8312c08793c455, 0x760cdf15b84cd4dd}},
+		},
+		{
+			fp2elt{fpelt{0xb448f89ba4aa3c39, 0x2fd247a52d846535}, fpelt{0x00e9a8d5b55cef47, 0x156fa1f2fa0bc76f}},
+			fp2elt{fpelt{0xb600519c05738211, 0x08018909f9855c61}, fpelt{0x0477678f9fe5b3a9, 0x3932e662f1eec620}},
+		},
+		{
+			fp2elt{fpelt{0x4bb707645b55c3c6, 0x502db85ad27b9aca}, fpelt{0xff16572a4aa310b8, 0x6a905e0d05f43890}},
+			fp2elt{fpelt{0xb600519c05738211, 0x08018909f9855c61}, fpelt{0x0477678f9fe5b3a9, 0x3932e662f1eec620}},
+		},
+		{
+			fp2elt{fpelt{0x9bf7c449280403e1, 0x07e7d2e37bebce69}, fpelt{0x07097d00a4d619bb, 0x1ff0346f5c7ace44}},
+			fp2elt{fpelt{0x180597b5d09f9511, 0x5175279da143afa6}, fpelt{0x9258e5df71b7fbfd, 0x4d242c4fb934ed48}},
+		},
+		{
+			fp2elt{fpelt{0x64083bb6d7fbfc1e, 0x78182d1c84143196}, fpelt{0xf8f682ff5b29e644, 0x600fcb90a38531bb}},
+			fp2elt{fpelt{0x180597b5d09f9511, 0x5175279da143afa6}, fpelt{0x9258e5df71b7fbfd, 0x4d242c4fb934ed48}},
+		},
+		{
+			fp2elt{fpelt{0x47f2d412fb4a4cba, 0x114a56514a54b905}, fpelt{0xb22b49b3052cbf15, 0x199206d0d8375518}},
+			fp2elt{fpelt{0xa2d912256cefd912, 0x4739a42133bbbc0c}, fpelt{0x98f0e939d9bcc9d6, 0x04330a5f988b97f3}},
+		},
+		{
+			fp2elt{fpelt{0xb80d2bed04b5b345, 0x6eb5a9aeb5ab46fa}, fpelt{0x4dd4b64cfad340ea, 0x666df92f27c8aae7}},
+			fp2elt{fpelt{0xa2d912256cefd912, 0x4739a42133bbbc0c}, fpelt{0x98f0e939d9bcc9d6, 0x04330a5f988b97f3}},
+		},
+		{
+			fp2elt{fpelt{0xc83b0bb3fca2ef41, 0x0e0ac36ba2066a1a}, fpelt{0xe66158bde7063f5f, 0x5c4497950826ca6b}},
+			fp2elt{fpelt{0x08ecbaff1febf012, 0x471306ff6cc830bf}, fpelt{0xee3b0abf7fc27fb1, 0x4faf737a1e91a82a}},
+		},
+		{
+			fp2elt{fpelt{0x37c4f44c035d10be, 0x71f53c945df995e5}, fpelt{0x199ea74218f9c0a0, 0x23bb686af7d93594}},
+			fp2elt{fpelt{0x08ecbaff1febf012, 0x471306ff6cc830bf}, fpelt{0xee3b0abf7fc27fb1, 0x4faf737a1e91a82a}},
+		},
+		{
+			fp2elt{fpelt{0x8ffc1b7079c06760, 0x17cbe1b8f02fb404}, fpelt{0xe255773d91663c14, 0x43506315604d2fd3}},
+			fp2elt{fpelt{0xe255773d91663c14, 0x43506315604d2fd3}, fpelt{0x7003e48f863f989f, 0x68341e470fd04bfb}},
+		},
+		{
+			fp2elt{fpelt{0x7003e48f863f989f, 0x68341e470fd04bfb}, fpelt{0x1daa88c26e99c3eb, 0x3caf9cea9fb2d02c}},
+			fp2elt{fpelt{0xe255773d91663c14, 0x43506315604d2fd3}, fpelt{0x7003e48f863f989f, 0x68341e470fd04bfb}},
+		},
+		{
+			fp2elt{fpelt{0x98f0e939d9bcc9d6, 0x04330a5f988b97f3}, fpelt{0x5d26edda931026ed, 0x38c65bdecc4443f3}},
+			fp2elt{fpelt{0xb22b49b3052cbf15, 0x199206d0d8375518}, fpelt{0xb80d2bed04b5b345, 0x6eb5a9aeb5ab46fa}},
+		},
+		{
+			fp2elt{fpelt{0x670f16c626433629, 0x7bccf5a06774680c}, fpelt{0xa2d912256cefd912, 0x4739a42133bbbc0c}},
+			fp2elt{fpelt{0xb22b49b3052cbf15, 0x199206d0d8375518}, fpelt{0xb80d2bed04b5b345, 0x6eb5a9aeb5ab46fa}},
+		},
+		{
+			fp2elt{fpelt{0x1c90b08806ec9351, 0x241d6b6652047b41}, fpelt{0x7487934b45a90e01, 0x13ebfea2f0593b9d}},
+			fp2elt{fpelt{0x60090a3e0f063b16, 0x1c168837c3c29503}, fpelt{0x42bd91088b297763, 0x20225cfe6982bc71}},
+		},
+		{
+			fp2elt{fpelt{0xe36f4f77f9136cae, 0x5be29499adfb84be}, fpelt{0x8b786cb4ba56f1fe, 0x6c14015d0fa6c462}},
+			fp2elt{fpelt{0x60090a3e0f063b16, 0x1c168837c3c29503}, fpelt{0x42bd91088b297763, 0x20225cfe6982bc71}},
+		},
+		{
+			fp2elt{fpelt{0xba9dfadcc2772ab6, 0x340be924a3807210}, fpelt{0x337c3e5f11240ba8, 0x4e5ebe04daa29ac6}},
+			fp2elt{fpelt{0x8f98088167e05018, 0x51a7888488885f7e}, fpelt{0x38a312c67fc23bad, 0x19e6d8d8555536d5}},
+		},
+		{
+			fp2elt{fpelt{0x456205233d88d549, 0x4bf416db5c7f8def}, fpelt{0xcc83c1a0eedbf457, 0x31a141fb255d6539}},
+			fp2elt{fpelt{0x8f98088167e05018, 0x51a7888488885f7e}, fpelt{0x38a312c67fc23bad, 0x19e6d8d8555536d5}},
+		},
+		{
+			fp2elt{fpelt{0x05ff47d19e712c2f, 0x02e3b4ecaa3c8abd}, fpelt{0xa6e53bbfd02fe55a, 0x058aa16ee6b83c86}},
+			fp2elt{fpelt{0x267186643dd8f61c, 0x6fe7c72f17611147}, fpelt{0x77aa7b70939be003, 0x442e91b4c162081e}},
+		},
+		{
+			fp2elt{fpelt{0xfa00b82e618ed3d0, 0x7d1c4b1355c37542}, fpelt{0x591ac4402fd01aa5, 0x7a755e911947c379}},
+			fp2elt{fpelt{0x267186643dd8f61c, 0x6fe7c72f17611147}, fpelt{0x77aa7b70939be003, 0x442e91b4c162081e}},
+		},
+		{
+			fp2elt{fpelt{0x5f8d4a89e285db91, 0x2fa97e664738a2cd}, fpelt{0xf840f2556bbf2e34, 0x077607391a69ea03}},
+			fp2elt{fpelt{0xd69d815cd3d0c61e, 0x355ae643006e0d39}, fpelt{0xb139f2046633d55f, 0x181816466e360f95}},
+		},
+		{
+			fp2elt{fpelt{0xa072b5761d7a246e, 0x50568199b8c75d32}, fpelt{0x07bf0daa9440d1cb, 0x7889f8c6e59615fc}},
+			fp2elt{fpelt{0xd69d815cd3d0c61e, 0x355ae643006e0d39}, fpelt{0xb139f2046633d55f, 0x181816466e360f95}},
+		},
+		{
+			fp2elt{fpelt{0xe09c24b8e5f51a0e, 0x2c8e320769c4c3ed}, fpelt{0x10bededdc41b8e50, 0x271afea8fd9845ed}},
+			fp2elt{fpelt{0x28ad8fda9a4d2521, 0x56d2ea1302fd3da2}, fpelt{0x276c1e55fbde1a3f, 0x0b79b929abbdaf64}},
+		},
+		{
+			fp2elt{fpelt{0x1f63db471a0ae5f1, 0x5371cdf8963b3c12}, fpelt{0xef4121223be471af, 0x58e501570267ba12}},
+			fp2elt{fpelt{0x28ad8fda9a4d2521, 0x56d2ea1302fd3da2}, fpelt{0x276c1e55fbde1a3f, 0x0b79b929abbdaf64}},
+		},
+		{
+			fp2elt{fpelt{0x0a4e2b3992ef3bd9, 0x3a7a98c0ead3a1a0}, fpelt{0xeed30eb6221967f5, 0x208405db59470d56}},
+			fp2elt{fpelt{0xe3402a33c7221e24, 0x0a19c84c0affa311}, fpelt{0xd49f6f80705df7ba, 0x6024a6c7ee7784d9}},
+		},
+		{
+			fp2elt{fpelt{0xf5b1d4c66d10c426, 0x4585673f152c5e5f}, fpelt{0x112cf149dde6980a, 0x5f7bfa24a6b8f2a9}},
+			fp2elt{fpelt{0xe3402a33c7221e24, 0x0a19c84c0affa311}, fpelt{0xd49f6f80705df7ba, 0x6024a6c7ee7784d9}},
+		},
+		{
+			fp2elt{fpelt{0xb8f97c7a6805ba86, 0x2dd1d1d7563d1c8a}, fpelt{0xcdbb81a753ca194d, 0x38d2fb686b216ec8}},
+			fp2elt{fpelt{0xfbd86e3d4e7db324, 0x1cdea748f9102bec}, fpelt{0x764f1fc02f7da6dc, 0x5b26119a1394cdc5}},
+		},
+		{
+			fp2elt{fpelt{0x4706838597fa4579, 0x522e2e28a9c2e375}, fpelt{0x32447e58ac35e6b2, 0x472d049794de9137}},
+			fp2elt{fpelt{0xfbd86e3d4e7db324, 0x1cdea748f9102bec}, fpelt{0x764f1fc02f7da6dc, 0x5b26119a1394cdc5}},
+		},
+		{
+			fp2elt{fpelt{0x70fc9b2f912d9f4b, 0x262e6280b03c7a29}, fpelt{0x0609a4c440fa2fc7, 0x4ff0f340e2a5fada}},
+			fp2elt{fpelt{0x60cc0f352e2ebf25, 0x54fbff11f779ef0b}, fpelt{0x5ac68f185595c25a, 0x618ac2b52f865860}},
+		},
+		{
+			fp2elt{fpelt{0x8f0364d06ed260b4, 0x59d19d7f4fc385d6}, fpelt{0xf9f65b3bbf05d038, 0x300f0cbf1d5a0525}},
+			fp2elt{fpelt{0x60cc0f352e2ebf25, 0x54fbff11f779ef0b}, fpelt{0x5ac68f185595c25a, 0x618ac2b52f865860}},
+		},
+		{
+			fp2elt{fpelt{0xf37111e81638aaa0, 0x246487cb2acd7478}, fpelt{0x140097093cdfbab2, 0x58ff3488f3aca3e4}},
+			fp2elt{fpelt{0x1e16e227c1ea4827, 0x2cac7fa9038d578d}, fpelt{0x5307a5fdfd769888, 0x6157f444316a1056}},
+		},
+		{
+			fp2elt{fpelt{0x0c8eee17e9c7555f, 0x5b9b7834d5328b87}, fpelt{0xebff68f6c320454d, 0x2700cb770c535c1b}},
+			fp2elt{fpelt{0x1e16e227c1ea4827, 0x2cac7fa9038d578d}, fpelt{0x5307a5fdfd769888, 0x6157f444316a1056}},
+		},
+		{
+			fp2elt{fpelt{0xa22b23f8af95c6a9, 0x14e744a7324700cf}, fpelt{0x5a75d7c8333bc6fe, 0x08e4cbe44b241195}},
+			fp2elt{fpelt{0x2bf3375a533f9127, 0x18f7c36966869385}, fpelt{0x4bdce060b30b331a, 0x51e53a7bd41640b7}},
+		},
+		{
+			fp2elt{fpelt{0x5dd4dc07506a3956, 0x6b18bb58cdb8ff30}, fpelt{0xa58a2837ccc43901, 0x771b341bb4dbee6a}},
+			fp2elt{fpelt{0x2bf3375a533f9127, 0x18f7c36966869385}, fpelt{0x4bdce060b30b331a, 0x51e53a7bd41640b7}},
+		},
+		{
+			fp2elt{fpelt{0xeef4a9e67c11e433, 0x24c212265db1c576}, fpelt{0xa36530337903132a, 0x5b1689b8dfe6dbcd}},
+			fp2elt{fpelt{0xfa2f379db817f827, 0x5bfc4cb429e0da5a}, fpelt{0x87f36665ed8ee756, 0x0cd4c294e19270f1}},
+		},
+		{
+			fp2elt{fpelt{0x110b561983ee1bcc, 0x5b3dedd9a24e3a89}, fpelt{0x5c9acfcc86fcecd5, 0x24e9764720192432}},
+			fp2elt{fpelt{0xfa2f379db817f827, 0x5bfc4cb429e0da5a}, fpelt{0x87f36665ed8ee756, 0x0cd4c294e19270f1}},
+		},
+		{
+			fp2elt{fpelt{0xe3b209f6d0528e20, 0x105ba2865134c01d}, fpelt{0x04836dcb0bc81859, 0x1aad3f1e82592105}},
+			fp2elt{fpelt{0xcd009165afd2c128, 0x7509cfa1d6c1c043}, fpelt{0x06e30bf62a324131, 0x4ea1935e942d17e9}},
+		},
+		{
+			fp2elt{fpelt{0x1c4df6092fad71df, 0x6fa45d79aecb3fe2}, fpelt{0xfb7c9234f437e7a6, 0x6552c0e17da6defa}},
+			fp2elt{fpelt{0xcd009165afd2c128, 0x7509cfa1d6c1c043}, fpelt{0x06e30bf62a324131, 0x4ea1935e942d17e9}},
+		},
+		{
+			fp2elt{fpelt{0xc57ced3f786c3baa, 0x09f320ea47b32b22}, fpelt{0xa3d29eaf6e48830d, 0x0a48b93dde2c79c9}},
+			fp2elt{fpelt{0xb9cb12bbce7e4629, 0x57f74a5631809964}, fpelt{0x53a3d123151c957f, 0x6b5aa1549e8cf31c}},
+		},
+		{
+			fp2elt{fpelt{0x3a8312c08793c455, 0x760cdf15b84cd4dd}, fpelt{0x5c2d615091b77cf2, 0x75b746c221d38636}},
+			fp2elt{fpelt{0xb9cb12bbce7e4629, 0x57f74a5631809964}, fpelt{0x53a3d123151c957f, 0x6b5aa1549e8cf31c}},
+		},
+		{
+			fp2elt{fpelt{0x25d758839145c409, 0x09d60809d6f9a182}, fpelt{0x896e9e305798d770, 0x62f20983304cacd2}},
+			fp2elt{fpelt{0x80fa9e5cbd4d102a, 0x5324d65ea370099c}, fpelt{0x995ca3e337c6d246, 0x190fd965924de9c6}},
+		},
+		{
+			fp2elt{fpelt{0xda28a77c6eba3bf6, 0x7629f7f629065e7d}, fpelt{0x769161cfa867288f, 0x1d0df67ccfb3532d}},
+			fp2elt{fpelt{0x80fa9e5cbd4d102a, 0x5324d65ea370099c}, fpelt{0x995ca3e337c6d246, 0x190fd965924de9c6}},
+		},
+		{
+			fp2elt{fpelt{0x87f36665ed8ee756, 0x0cd4c294e19270f1}, fpelt{0x05d0c86247e807d8, 0x2403b34bd61f25a5}},
+			fp2elt{fpelt{0xa36530337903132a, 0x5b1689b8dfe6dbcd}, fpelt{0x110b561983ee1bcc, 0x5b3dedd9a24e3a89}},
+		},
+		{
+			fp2elt{fpelt{0x780c999a127118a9, 0x732b3d6b1e6d8f0e}, fpelt{0xfa2f379db817f827, 0x5bfc4cb429e0da5a}},
+			fp2elt{fpelt{0xa36530337903132a, 0x5b1689b8dfe6dbcd}, fpelt{0x110b561983ee1bcc, 0x5b3dedd9a24e3a89}},
+		},
+		{
+			fp2elt{fpelt{0x241ca97faa7fcc9e, 0x397eca2b7d2a2a56}, fpelt{0x0cdfc468504ccec2, 0x61457c849f1c7c3b}},
+			fp2elt{fpelt{0x7b285ef048c5522c, 0x2bb23c8b80b64e14}, fpelt{0x21e83c5d071c82fb, 0x27ac9b7f5e8548c2}},
+		},
+		{
+			fp2elt{fpelt{0xdbe3568055803361, 0x468135d482d5d5a9}, fpelt{0xf3203b97afb3313d, 0x1eba837b60e383c4}},
+			fp2elt{fpelt{0x7b285ef048c5522c, 0x2bb23c8b80b64e14}, fpelt{0x21e83c5d071c82fb, 0x27ac9b7f5e8548c2}},
+		},
+		{
+			fp2elt{fpelt{0xa7789d7d70b97d33, 0x0176ab60c10abfa2}, fpelt{0x673c39241d2df5ff, 0x2f984ea7fb61760a}},
+			fp2elt{fpelt{0x3d159709ccba172d, 0x05b50a0043b08610}, fpelt{0x8cac6288617a4876, 0x46181c7a7d67a411}},
+		},
+		{
+			fp2elt{fpelt{0x588762828f4682cc, 0x7e89549f3ef5405d}, fpelt{0x98c3c6dbe2d20a00, 0x5067b158049e89f5}},
+			fp2elt{fpelt{0x3d159709ccba172d, 0x05b50a0043b08610}, fpelt{0x8cac6288617a4876, 0x46181c7a7d67a411}},
+		},
+		{
+			fp2elt{fpelt{0x3c9b73c751b4b192, 0x1318020702de23bc}, fpelt{0x18e3c409fbd81a95, 0x77ab39a7d8990c0a}},
+			fp2elt{fpelt{0x1ea2b43b5121a22e, 0x515854b6d19cc2da}, fpelt{0xd74dff5063e66682, 0x763f89e129497361}},
+		},
+		{
+			fp2elt{fpelt{0xc3648c38ae4b4e6d, 0x6ce7fdf8fd21dc43}, fpelt{0xe71c3bf60427e56a, 0x0854c6582766f3f5}},
+			fp2elt{fpelt{0x1ea2b43b5121a22e, 0x515854b6d19cc2da}, fpelt{0xd74dff5063e66682, 0x763f89e129497361}},
+		},
+		{
+			fp2elt{fpelt{0xb9af5d0c6ae5c074, 0x33a346a7083bcddd}, fpelt{0xf759bdd9e536774e, 0x02ad0e0570efb122}},
+			fp2elt{fpelt{0xc0462de4c4aea830, 0x16c1e52761d69243}, fpelt{0x78a72abff3ee36a2, 0x4068dbdd99043114}},
+		},
+		{
+			fp2elt{fpelt{0x4650a2f3951a3f8b, 0x4c5cb958f7c43222}, fpelt{0x08a642261ac988b1, 0x7d52f1fa8f104edd}},
+			fp2elt{fpelt{0xc0462de4c4aea830, 0x16c1e52761d69243}, fpelt{0x78a72abff3ee36a2, 0x4068dbdd99043114}},
+		},
+		{
+			fp2elt{fpelt{0x18ab5cc3e899f879, 0x0508f6e5bc738db6}, fpelt{0x415a03c7d4c664aa, 0x3e3f823b64ac6798}},
+			fp2elt{fpelt{0xd49c3def3473c830, 0x262c3ce58ce4cf4c}, fpelt{0xb2d76ec171fe470e, 0x0d6995a8ed492a18}},
+		},
+		{
+			fp2elt{fpelt{0xe754a33c17660786, 0x7af7091a438c7249}, fpelt{0xbea5fc382b399b55, 0x41c07dc49b539867}},
+			fp2elt{fpelt{0xd49c3def3473c830, 0x262c3ce58ce4cf4c}, fpelt{0xb2d76ec171fe470e, 0x0d6995a8ed492a18}},
+		},
+		{
+			fp2elt{fpelt{0xdd57cd1f18046539, 0x1c1ef3ffafcd3359}, fpelt{0xacdb0da489b38481, 0x12e446a2db3fbbc3}},
+			fp2elt{fpelt{0x3184f05ccdf1d030, 0x1f28d59de76b2056}, fpelt{0x75349806637fa9f0, 0x6d6558f81c8d97da}},
+		},
+		{
+			fp2elt{fpelt{0x22a832e0e7fb9ac6, 0x63e10c005032cca6}, fpelt{0x5324f25b764c7b7e, 0x6d1bb95d24c0443c}},
+			fp2elt{fpelt{0x3184f05ccdf1d030, 0x1f28d59de76b2056}, fpelt{0x75349806637fa9f0, 0x6d6558f81c8d97da}},
+		},
+		{
+			fp2elt{fpelt{0x1c7fefeba01cc47c, 0x13ba611f58f42ead}, fpelt{0x41a2711c17aa1bcb, 0x113a78ef13a246fb}},
+			fp2elt{fpelt{0xdd7464416ae06f31, 0x639602ee0215620d}, fpelt{0xe0e0091db2117d76, 0x1b7077af978ae4c8}},
+		},
+		{
+			fp2elt{fpelt{0xe38010145fe33b83, 0x6c459ee0a70bd152}, fpelt{0xbe5d8ee3e855e434, 0x6ec58710ec5db904}},
+			fp2elt{fpelt{0xdd7464416ae06f31, 0x639602ee0215620d}, fpelt{0xe0e0091db2117d76, 0x1b7077af978ae4c8}},
+		},
+		{
+			fp2elt{fpelt{0xf47105eedcf0cabf, 0x0e0233906aadf306}, fpelt{0x810c0f0f4aa8a17b, 0x3e8849360050af33}},
+			fp2elt{fpelt{0x9a88e5a6b982ca32, 0x2ecd5669cdcfc954}, fpelt{0xa900a57793754173, 0x5433ad4d3b0bebfe}},
+		},
+		{
+			fp2elt{fpelt{0x0b8efa11230f3540, 0x71fdcc6f95520cf9}, fpelt{0x7ef3f0f0b5575e84, 0x4177b6c9ffaf50cc}},
+			fp2elt{fpelt{0x9a88e5a6b982ca32, 0x2ecd5669cdcfc954}, fpelt{0xa900a57793754173, 0x5433ad4d3b0bebfe}},
+		},
+		{
+			fp2elt{fpelt{0xb139f2046633d55f, 0x181816466e360f95}, fpelt{0x29627ea32c2f39e1, 0x4aa519bcff91f2c6}},
+			fp2elt{fpelt{0xf840f2556bbf2e34, 0x077607391a69ea03}, fpelt{0xa072b5761d7a246e, 0x50568199b8c75d32}},
+		},
+		{
+			fp2elt{fpelt{0x4ec60dfb99cc2aa0, 0x67e7e9b991c9f06a}, fpelt{0xd69d815cd3d0c61e, 0x355ae643006e0d39}},
+			fp2elt{fpelt{0xf840f2556bbf2e34, 0x077607391a69ea03}, fpelt{0xa072b5761d7a246e, 0x50568199b8c75d32}},
+		},
+		{
+			fp2elt{fpelt{0xe0e0091db2117d76, 0x1b7077af978ae4c8}, fpelt{0x228b9bbe951f90ce, 0x1c69fd11fdea9df2}},
+			fp2elt{fpelt{0xbe5d8ee3e855e434, 0x6ec58710ec5db904}, fpelt{0x1c7fefeba01cc47c, 0x13ba611f58f42ead}},
+		},
+		{
+			fp2elt{fpelt{0x1f1ff6e24dee8289, 0x648f885068751b37}, fpelt{0xdd7464416ae06f31, 0x639602ee0215620d}},
+			fp2elt{fpelt{0xbe5d8ee3e855e434, 0x6ec58710ec5db904}, fpelt{0x1c7fefeba01cc47c, 0x13ba611f58f42ead}},
+		},
+		{
+			fp2elt{fpelt{0xe4ab575792e5ab35, 0x134ab45a78240a77}, fpelt{0xf672b649cb576b02, 0x0299cbc61a2b2a7b}},
+			fp2elt{fpelt{0x66f8741abffb4c35, 0x3f3197c376b70e17}, fpelt{0x79ad8ac9b83fcef3, 0x2f68673441b775e6}},
+		},
+		{
+			fp2elt{fpelt{0x1b54a8a86d1a54ca, 0x6cb54ba587dbf588}, fpelt{0x098d49b634a894fd, 0x7d663439e5d4d584}},
+			fp2elt{fpelt{0x66f8741abffb4c35, 0x3f3197c376b70e17}, fpelt{0x79ad8ac9b83fcef3, 0x2f68673441b775e6}},
+		},
+		{
+			fp2elt{fpelt{0x713e75bab956240a, 0x0b44aa8185c0f366}, fpelt{0xe4b64166d023d3b7, 0x34164be923e05e06}},
+			fp2elt{fpelt{0x950fc8bc7f976235, 0x30702031e6681858}, fpelt{0xcd219ccff4030451, 0x22f3e5c3e981075a}},
+		},
+		{
+			fp2elt{fpelt{0x8ec18a4546a9dbf5, 0x74bb557e7a3f0c99}, fpelt{0x1b49be992fdc2c48, 0x4be9b416dc1fa1f9}},
+			fp2elt{fpelt{0x950fc8bc7f976235, 0x30702031e6681858}, fpelt{0xcd219ccff4030451, 0x22f3e5c3e981075a}},
+		},
+		{
+			fp2elt{fpelt{0xa53970e7aa6a3da5, 0x1e753d4ad079a79f}, fpelt{0x60cc0f352e2ebf25, 0x54fbff11f779ef0b}},
+			fp2elt{fpelt{0xf9f65b3bbf05d038, 0x300f0cbf1d5a0525}, fpelt{0x70fc9b2f912d9f4b, 0x262e6280b03c7a29}},
+		},
+		{
+			fp2elt{fpelt{0x5ac68f185595c25a, 0x618ac2b52f865860}, fpelt{0x9f33f0cad1d140da, 0x2b0400ee088610f4}},
+			fp2elt{fpelt{0xf9f65b3bbf05d038, 0x300f0cbf1d5a0525}, fpelt{0x70fc9b2f912d9f4b, 0x262e6280b03c7a29}},
+		},
+		{
+			fp2elt{fpelt{0x43230a76b806d45a, 0x1edfd25addbd6211}, fpelt{0xc4a73239c918c9a0, 0x71c9d0764cc727d0}},
+			fp2elt{fpelt{0xe447bf60d2e1753a, 0x4f05d01f082a70e9}, fpelt{0x42c084208c217ac6, 0x64ccb4d8162ec1ec}},
+		},
+		{
+			fp2elt{fpelt{0xbcdcf58947f92ba5, 0x61202da522429dee}, fpelt{0x3b58cdc636e7365f, 0x0e362f89b338d82f}},
+			fp2elt{fpelt{0xe447bf60d2e1753a, 0x4f05d01f082a70e9}, fpelt{0x42c084208c217ac6, 0x64ccb4d8162ec1ec}},
+		},
+		{
+			fp2elt{fpelt{0xeb4889504633a7b1, 0x235f89ae8c4943f5}, fpelt{0x619fac301db3e861, 0x755755b218a93db2}},
+			fp2elt{fpelt{0xf5a8655f0c31323b, 0x20cf24f012a68533}, fpelt{0x670e161f3191be2e, 0x02fd7f9ed8495c3a}},
+		},
+		{
+			fp2elt{fpelt{0x14b776afb9cc584e, 0x5ca0765173b6bc0a}, fpelt{0x9e6053cfe24c179e, 0x0aa8aa4de756c24d}},
+			fp2elt{fpelt{0xf5a8655f0c31323b, 0x20cf24f012a68533}, fpelt{0x670e161f3191be2e, 0x02fd7f9ed8495c3a}},
+		},
+		{
+			fp2elt{fpelt{0x9b7935ede21e7e6d, 0x14b69b2eaf07ba0d}, fpelt{0xdba41d9c8c9c0af5, 0x27570f9a6e26b9f9}},
+			fp2elt{fpelt{0x333ac5403acd613b, 0x4111f00c69884394}, fpelt{0xa33487249f00b0ff, 0x68884699f636bfa8}},
+		},
+		{
+			fp2elt{fpelt{0x6486ca121de18192, 0x6b4964d150f845f2}, fpelt{0x245be2637363f50a, 0x58a8f06591d94606}},
+			fp2elt{fpelt{0x333ac5403acd613b, 0x4111f00c69884394}, fpelt{0xa33487249f00b0ff, 0x68884699f636bfa8}},
+		},
+		{
+			fp2elt{fpelt{0xa9b0969945275b61, 0x2b2e52b01637aa2f}, fpelt{0x5c61e1c33a4cd19e, 0x0d53645353b9bfcf}},
+			fp2elt{fpelt{0x2d4f137b86f7c23b, 0x04ce51caa87e496a}, fpelt{0x49f88a9b5cde8e63, 0x0b472d167cd5406f}},
+		},
+		{
+			fp2elt{fpelt{0x564f6966bad8a49e, 0x54d1ad4fe9c855d0}, fpelt{0xa39e1e3cc5b32e61, 0x72ac9bacac464030}},
+			fp2elt{fpelt{0x2d4f137b86f7c23b, 0x04ce51caa87e496a}, fpelt{0x49f88a9b5cde8e63, 0x0b472d167cd5406f}},
+		},
+		{
+			fp2elt{fpelt{0x21e83c5d071c82fb, 0x27ac9b7f5e8548c2}, fpelt{0x84d7a10fb73aadd3, 0x544dc3747f49b1eb}},
+			fp2elt{fpelt{0xf3203b97afb3313d, 0x1eba837b60e383c4}, fpelt{0x241ca97faa7fcc9e, 0x397eca2b7d2a2a56}},
+		},
+		{
+			fp2elt{fpelt{0xde17c3a2f8e37d04, 0x58536480a17ab73d}, fpelt{0x7b285ef048c5522c, 0x2bb23c8b80b64e14}},
+			fp2elt{fpelt{0xf3203b97afb3313d, 0x1eba837b60e383c4}, fpelt{0x241ca97faa7fcc9e, 0x397eca2b7d2a2a56}},
+		},
+		{
+			fp2elt{fpelt{0x1f58edfa1a60b426, 0x0fdd975e27ed6a58}, fpelt{0x3acbb6f9a0d58974, 0x2c339a351f104e03}},
+			fp2elt{fpelt{0xae081fa1db59c53f, 0x22c964b8a6bf1ee0}, fpelt{0x42e49595f2db3a1a, 0x3cae367c8099edca}},
+		},
+		{
+			fp2elt{fpelt{0xe0a71205e59f4bd9, 0x702268a1d81295a7}, fpelt{0xc53449065f2a768b, 0x53cc65cae0efb1fc}},
+			fp2elt{fpelt{0xae081fa1db59c53f, 0x22c964b8a6bf1ee0}, fpelt{0x42e49595f2db3a1a, 0x3cae367c8099edca}},
+		},
+		{
+			fp2elt{fpelt{0xd513d5a2d8bf057c, 0x0819ee5d76062217}, fpelt{0x45755a772bb1ca4b, 0x0d15e3caa9364943}},
+			fp2elt{fpelt{0x08653ef052222d41, 0x21ec6ebcc395c073}, fpelt{0x3e3367c1ea7307d2, 0x4a4d37b415229df0}},
+		},
+		{
+			fp2elt{fpelt{0x2aec2a5d2740fa83, 0x77e611a289f9dde8}, fpelt{0xba8aa588d44e35b4, 0x72ea1c3556c9b6bc}},
+			fp2elt{fpelt{0x08653ef052222d41, 0x21ec6ebcc395c073}, fpelt{0x3e3367c1ea7307d2, 0x4a4d37b415229df0}},
+		},
+		{
+			fp2elt{fpelt{0x94a7ad614005b358, 0x04b823d6d3577a50}, fpelt{0x2cb38417d64493f5, 0x1decf07ffbb875e9}},
+			fp2elt{fpelt{0x80a8e783bb6ae641, 0x04b4e4a7c29fc786}, fpelt{0x7ad371dc962eb88e, 0x6b2c89a899ae3a29}},
+		},
+		{
+			fp2elt{fpelt{0x6b58529ebffa4ca7, 0x7b47dc292ca885af}, fpelt{0xd34c7be829bb6c0a, 0x62130f8004478a16}},
+			fp2elt{fpelt{0x80a8e783bb6ae641, 0x04b4e4a7c29fc786}, fpelt{0x7ad371dc962eb88e, 0x6b2c89a899ae3a29}},
+		},
+		{
+			fp2elt{fpelt{0x328c91176db9f2e4, 0x14fb45c745df3a0d}, fpelt{0xfba0831e6a493163, 0x09b0b08a9a8fb137}},
+			fp2elt{fpelt{0xab0e1585f3fc4f43, 0x4f98d27a74b4033f}, fpelt{0xbb93562a93ce8f80, 0x2e65e96ad8deb857}},
+		},
+		{
+			fp2elt{fpelt{0xcd736ee892460d1b, 0x6b04ba38ba20c5f2}, fpelt{0x045f7ce195b6ce9c, 0x764f4f7565704ec8}},
+			fp2elt{fpelt{0xab0e1585f3fc4f43, 0x4f98d27a74b4033f}, fpelt{0xbb93562a93ce8f80, 0x2e65e96ad8deb857}},
+		},
+		{
+			fp2elt{fpelt{0x6da71a208e480402, 0x32dbd3b046cb12b7}, fpelt{0x180597b5d09f9511, 0x5175279da143afa6}},
+			fp2elt{fpelt{0xf8f682ff5b29e644, 0x600fcb90a38531bb}, fpelt{0x9bf7c449280403e1, 0x07e7d2e37bebce69}},
+		},
+		{
+			fp2elt{fpelt{0x9258e5df71b7fbfd, 0x4d242c4fb934ed48}, fpelt{0xe7fa684a2f606aee, 0x2e8ad8625ebc5059}},
+			fp2elt{fpelt{0xf8f682ff5b29e644, 0x600fcb90a38531bb}, fpelt{0x9bf7c449280403e1, 0x07e7d2e37bebce69}},
+		},
+		{
+			fp2elt{fpelt{0x0477678f9fe5b3a9, 0x3932e662f1eec620}, fpelt{0x49ffae63fa8c7dee, 0x77fe76f6067aa39e}},
+			fp2elt{fpelt{0x00e9a8d5b55cef47, 0x156fa1f2fa0bc76f}, fpelt{0x4bb707645b55c3c6, 0x502db85ad27b9aca}},
+		},
+		{
+			fp2elt{fpelt{0xfb889870601a4c56, 0x46cd199d0e1139df}, fpelt{0xb600519c05738211, 0x08018909f9855c61}},
+			fp2elt{fpelt{0x00e9a8d5b55cef47, 0x156fa1f2fa0bc76f}, fpelt{0x4bb707645b55c3c6, 0x502db85ad27b9aca}},
+		},
+		{
+			fp2elt{fpelt{0xcd219ccff4030451, 0x22f3e5c3e981075a}, fpelt{0x6af0374380689dca, 0x4f8fdfce1997e7a7}},
+			fp2elt{fpelt{0x1b49be992fdc2c48, 0x4be9b416dc1fa1f9}, fpelt{0x713e75bab956240a, 0x0b44aa8185c0f366}},
+		},
+		{
+			fp2elt{fpelt{0x32de63300bfcfbae, 0x5d0c1a3c167ef8a5}, fpelt{0x950fc8bc7f976235, 0x30702031e6681858}},
+			fp2elt{fpelt{0x1b49be992fdc2c48, 0x4be9b416dc1fa1f9}, fpelt{0x713e75bab956240a, 0x0b44aa8185c0f366}},
+		},
+		{
+			fp2elt{fpelt{0xe3e6e04b079e10bf, 0x05eeb114acd4461b}, fpelt{0x8123ff22707ba6f5, 0x7755ac53e4d3fba9}},
+			fp2elt{fpelt{0x60c8a58a031b9049, 0x53868d25e2e030c6}, fpelt{0xabcc4297dd94f468, 0x0e5b75da50d1797a}},
+		},
+		{
+			fp2elt{fpelt{0x1c191fb4f861ef40, 0x7a114eeb532bb9e4}, fpelt{0x7edc00dd8f84590a, 0x08aa53ac1b2c0456}},
+			fp2elt{fpelt{0x60c8a58a031b9049, 0x53868d25e2e030c6}, fpelt{0xabcc4297dd94f468, 0x0e5b75da50d1797a}},
+		},
+		{
+			fp2elt{fpelt{0xa3a379f915b9c203, 0x2c148c94bd40041f}, fpelt{0xca99a8efac44f696, 0x02f7e4ed89f6ed14}},
+			fp2elt{fpelt{0xb2ab2498d0ba5d4a, 0x6d77c07d63617522}, fpelt{0xfece2d94d0ba528e, 0x12fa2d17772e32e6}},
+		},
+		{
+			fp2elt{fpelt{0x5c5c8606ea463dfc, 0x53eb736b42bffbe0}, fpelt{0x3566571053bb0969, 0x7d081b12760912eb}},
+			fp2elt{fpelt{0xb2ab2498d0ba5d4a, 0x6d77c07d63617522}, fpelt{0xfece2d94d0ba528e, 0x12fa2d17772e32e6}},
+		},
+		{
+			fp2elt{fpelt{0xfe0e54f3fed8b6e5, 0x2ab1ca4986bd6b1e}, fpelt{0xfd9bda1df6fa736d, 0x4b9d3edaa72baffb}},
+			fp2elt{fpelt{0xe44c0a9c344ea44b, 0x13aeb22058009c4f}, fpelt{0x3640909707ae18b8, 0x58f2a1e44b89ec1b}},
+		},
+		{
+			fp2elt{fpelt{0x01f1ab0c0127491a, 0x554e35b6794294e1}, fpelt{0x026425e209058c92, 0x3462c12558d45004}},
+			fp2elt{fpelt{0xe44c0a9c344ea44b, 0x13aeb22058009c4f}, fpelt{0x3640909707ae18b8, 0x58f2a1e44b89ec1b}},
+		},
+		{
+			fp2elt{fpelt{0xc1cc983e158cf82d, 0x35b2c84beadd620f}, fpelt{0x08653ef052222d41, 0x21ec6ebcc395c073}},
+			fp2elt{fpelt{0x45755a772bb1ca4b, 0x0d15e3caa9364943}, fpelt{0x2aec2a5d2740fa83, 0x77e611a289f9dde8}},
+		},
+		{
+			fp2elt{fpelt{0x3e3367c1ea7307d2, 0x4a4d37b415229df0}, fpelt{0xf79ac10fadddd2be, 0x5e1391433c6a3f8c}},
+			fp2elt{fpelt{0x45755a772bb1ca4b, 0x0d15e3caa9364943}, fpelt{0x2aec2a5d2740fa83, 0x77e611a289f9dde8}},
+		},
+		{
+			fp2elt{fpelt{0xe8f331dada1fe476, 0x151c0a5546806c8a}, fpelt{0x43d0ed0bcd69df6e, 0x454c48f13e9d8b7a}},
+			fp2elt{fpelt{0xc82b257ec949174d, 0x1e35d12b5d914be4}, fpelt{0xb63253d54f9a51db, 0x5640760072d5654b}},
+		},
+		{
+			fp2elt{fpelt{0x170cce2525e01b89, 0x6ae3f5aab97f9375}, fpelt{0xbc2f12f432962091, 0x3ab3b70ec1627485}},
+			fp2elt{fpelt{0xc82b257ec949174d, 0x1e35d12b5d914be4}, fpelt{0xb63253d54f9a51db, 0x5640760072d5654b}},
+		},
+		{
+			fp2elt{fpelt{0x89b0e03fd0825923, 0x24d9ee65ec6b323a}, fpelt{0xfbd86e3d4e7db324, 0x1cdea748f9102bec}},
+			fp2elt{fpelt{0xcdbb81a753ca194d, 0x38d2fb686b216ec8}, fpelt{0x4706838597fa4579, 0x522e2e28a9c2e375}},
+		},
+		{
+			fp2elt{fpelt{0x764f1fc02f7da6dc, 0x5b26119a1394cdc5}, fpelt{0x042791c2b1824cdb, 0x632158b706efd413}},
+			fp2elt{fpelt{0xcdbb81a753ca194d, 0x38d2fb686b216ec8}, fpelt{0x4706838597fa4579, 0x522e2e28a9c2e375}},
+		},
+		{
+			fp2elt{fpelt{0xacf85a0202896777, 0x1ea80bbbce95efa9}, fpelt{0x1e16e227c1ea4827, 0x2cac7fa9038d578d}},
+			fp2elt{fpelt{0xebff68f6c320454d, 0x2700cb770c535c1b}, fpelt{0xf37111e81638aaa0, 0x246487cb2acd7478}},
+		},
+		{
+			fp2elt{fpelt{0x5307a5fdfd769888, 0x6157f444316a1056}, fpelt{0xe1e91dd83e15b7d8, 0x53538056fc72a872}},
+			fp2elt{fpelt{0xebff68f6c320454d, 0x2700cb770c535c1b}, fpelt{0xf37111e81638aaa0, 0x246487cb2acd7478}},
+		},
+		{
+			fp2elt{fpelt{0x8758d5400c11c95d, 0x3f97242266fbceeb}, fpelt{0xc0462de4c4aea830, 0x16c1e52761d69243}},
+			fp2elt{fpelt{0xf759bdd9e536774e, 0x02ad0e0570efb122}, fpelt{0x4650a2f3951a3f8b, 0x4c5cb958f7c43222}},
+		},
+		{
+			fp2elt{fpelt{0x78a72abff3ee36a2, 0x4068dbdd99043114}, fpelt{0x3fb9d21b3b5157cf, 0x693e1ad89e296dbc}},
+			fp2elt{fpelt{0xf759bdd9e536774e, 0x02ad0e0570efb122}, fpelt{0x4650a2f3951a3f8b, 0x4c5cb958f7c43222}},
+		},
+		{
+			fp2elt{fpelt{0x276c1e55fbde1a3f, 0x0b79b929abbdaf64}, fpelt{0xd752702565b2dade, 0x292d15ecfd02c25d}},
+			fp2elt{fpelt{0x10bededdc41b8e50, 0x271afea8fd9845ed}, fpelt{0x1f63db471a0ae5f1, 0x5371cdf8963b3c12}},
+		},
+		{
+			fp2elt{fpelt{0xd893e1aa0421e5c0, 0x748646d65442509b}, fpelt{0x28ad8fda9a4d2521, 0x56d2ea1302fd3da2}},
+			fp2elt{fpelt{0x10bededdc41b8e50, 0x271afea8fd9845ed}, fpelt{0x1f63db471a0ae5f1, 0x5371cdf8963b3c12}},
+		},
+		{
+			fp2elt{fpelt{0xb2d76ec171fe470e, 0x0d6995a8ed492a18}, fpelt{0x2b63c210cb8c37cf, 0x59d3c31a731b30b3}},
+			fp2elt{fpelt{0xbea5fc382b399b55, 0x41c07dc49b539867}, fpelt{0x18ab5cc3e899f879, 0x0508f6e5bc738db6}},
+		},
+		{
+			fp2elt{fpelt{0x4d28913e8e01b8f1, 0x72966a5712b6d5e7}, fpelt{0xd49c3def3473c830, 0x262c3ce58ce4cf4c}},
+			fp2elt{fpelt{0xbea5fc382b399b55, 0x41c07dc49b539867}, fpelt{0x18ab5cc3e899f879, 0x0508f6e5bc738db6}},
+		},
+		{
+			fp2elt{fpelt{0xeb2f61d809bbc075, 0x0bda5dd35b06cd16}, fpelt{0xbf5e100ce72fb792, 0x3245eb20498fcc25}},
+			fp2elt{fpelt{0xb6d81f030fceb756, 0x7bb0a3fb061e7e81}, fpelt{0x06456282995d16d6, 0x3b6d0f77e00f125a}},
+		},
+		{
+			fp2elt{fpelt{0x14d09e27f6443f8a, 0x7425a22ca4f932e9}, fpelt{0x40a1eff318d0486d, 0x4dba14dfb67033da}},
+			fp2elt{fpelt{0xb6d81f030fceb756, 0x7bb0a3fb061e7e81}, fpelt{0x06456282995d16d6, 0x3b6d0f77e00f125a}},
+		},
+		{
+			fp2elt{fpelt{0x38a312c67fc23bad, 0x19e6d8d8555536d5}, fpelt{0x7067f77e981fafe7, 0x2e58777b7777a081}},
+			fp2elt{fpelt{0xcc83c1a0eedbf457, 0x31a141fb255d6539}, fpelt{0xba9dfadcc2772ab6, 0x340be924a3807210}},
+		},
+		{
+			fp2elt{fpelt{0xc75ced39803dc452, 0x66192727aaaac92a}, fpelt{0x8f98088167e05018, 0x51a7888488885f7e}},
+			fp2elt{fpelt{0xcc83c1a0eedbf457, 0x31a141fb255d6539}, fpelt{0xba9dfadcc2772ab6, 0x340be924a3807210}},
+		},
+		{
+			fp2elt{fpelt{0xf91cf409d5cdbece, 0x315e6ca16bd2e816}, fpelt{0xcd009165afd2c128, 0x7509cfa1d6c1c043}},
+			fp2elt{fpelt{0x04836dcb0bc81859, 0x1aad3f1e82592105}, fpelt{0x1c4df6092fad71df, 0x6fa45d79aecb3fe2}},
+		},
+		{
+			fp2elt{fpelt{0x06e30bf62a324131, 0x4ea1935e942d17e9}, fpelt{0x32ff6e9a502d3ed7, 0x0af6305e293e3fbc}},
+			fp2elt{fpelt{0x04836dcb0bc81859, 0x1aad3f1e82592105}, fpelt{0x1c4df6092fad71df, 0x6fa45d79aecb3fe2}},
+		},
+		{
+			fp2elt{fpelt{0x42c884db0948095d, 0x3073649f6d79c7d2}, fpelt{0xb181d53f3c963a8a, 0x3029cc95ffe0306a}},
+			fp2elt{fpelt{0x15f842796586e659, 0x3df3019e9b53c40a}, fpelt{0x090b19223a630336, 0x66c0a415e7d71bdf}},
+		},
+		{
+			fp2elt{fpelt{0xbd377b24f6b7f6a2, 0x4f8c9b609286382d}, fpelt{0x4e7e2ac0c369c575, 0x4fd6336a001fcf95}},
+			fp2elt{fpelt{0x15f842796586e659, 0x3df3019e9b53c40a}, fpelt{0x090b19223a630336, 0x66c0a415e7d71bdf}},
+		},
+		{
+			fp2elt{fpelt{0x379288d046f64cce, 0x2219bcb951a89211}, fpelt{0xe540eb7198aa5604, 0x50694f379cab4551}},
+			fp2elt{fpelt{0x2f88ac35b9941f5a, 0x7d678502d1998b7b}, fpelt{0x9362abfa094fe18d, 0x664b311f855cba15}},
+		},
+		{
+			fp2elt{fpelt{0xc86d772fb909b331, 0x5de64346ae576dee}, fpelt{0x1abf148e6755a9fb, 0x2f96b0c86354baae}},
+			fp2elt{fpelt{0x2f88ac35b9941f5a, 0x7d678502d1998b7b}, fpelt{0x9362abfa094fe18d, 0x664b311f855cba15}},
+		},
+		{
+			fp2elt{fpelt{0x8855848f6c641ffc, 0x3bd16e4b3e9df7e1}, fpelt{0x267186643dd8f61c, 0x6fe7c72f17611147}},
+			fp2elt{fpelt{0xa6e53bbfd02fe55a, 0x058aa16ee6b83c86}, fpelt{0xfa00b82e618ed3d0, 0x7d1c4b1355c37542}},
+		},
+		{
+			fp2elt{fpelt{0x77aa7b70939be003, 0x442e91b4c162081e}, fpelt{0xd98e799bc22709e3, 0x101838d0e89eeeb8}},
+			fp2elt{fpelt{0xa6e53bbfd02fe55a, 0x058aa16ee6b83c86}, fpelt{0xfa00b82e618ed3d0, 0x7d1c4b1355c37542}},
+		},
+		{
+			fp2elt{fpelt{0xb6c2099544e20ea7, 0x234c744272683e29}, fpelt{0x00aaf2d88a8755fb, 0x6538deaa71169889}},
+			fp2elt{fpelt{0x2e17d9306533895b, 0x105db478b5999e91}, fpelt{0x2ab455d004a1406f, 0x70d193453966e2e2}},
+		},
+		{
+			fp2elt{fpelt{0x493df66abb1df158, 0x5cb38bbd8d97c1d6}, fpelt{0xff550d277578aa04, 0x1ac721558ee96776}},
+			fp2elt{fpelt{0x2e17d9306533895b, 0x105db478b5999e91}, fpelt{0x2ab455d004a1406f, 0x70d193453966e2e2}},
+		},
+		{
+			fp2elt{fpelt{0x88c881c4fff63136, 0x2e88ebf04624a157}, fpelt{0x1db651ba22007297, 0x7d2558c236dd3254}},
+			fp2elt{fpelt{0xe570d3e70734df5c, 0x251effb1b3a0c66f}, fpelt{0xae1a4063b647abb3, 0x17e70e8eab5845e3}},
+		},
+		{
+			fp2elt{fpelt{0x77377e3b0009cec9, 0x5177140fb9db5ea8}, fpelt{0xe249ae45ddff8d68, 0x02daa73dc922cdab}},
+			fp2elt{fpelt{0xe570d3e70734df5c, 0x251effb1b3a0c66f}, fpelt{0xae1a4063b647abb3, 0x17e70e8eab5845e3}},
+		},
+		{
+			fp2elt{fpelt{0xbd3f7bdf73de8539, 0x1b334b27e9d13e13}, fpelt{0xe447bf60d2e1753a, 0x4f05d01f082a70e9}},
+			fp2elt{fpelt{0x3b58cdc636e7365f, 0x0e362f89b338d82f}, fpelt{0x43230a76b806d45a, 0x1edfd25addbd6211}},
+		},
+		{
+			fp2elt{fpelt{0x42c084208c217ac6, 0x64ccb4d8162ec1ec}, fpelt{0x1bb8409f2d1e8ac5, 0x30fa2fe0f7d58f16}},
+			fp2elt{fpelt{0x3b58cdc636e7365f, 0x0e362f89b338d82f}, fpelt{0x43230a76b806d45a, 0x1edfd25addbd6211}},
+		},
+		{
+			fp2elt{fpelt{0x11c4f540803d804e, 0x30508c85e16e57d5}, fpelt{0x08ecbaff1febf012, 0x471306ff6cc830bf}},
+			fp2elt{fpelt{0xe66158bde7063f5f, 0x5c4497950826ca6b}, fpelt{0x37c4f44c035d10be, 0x71f53c945df995e5}},
+		},
+		{
+			fp2elt{fpelt{0xee3b0abf7fc27fb1, 0x4faf737a1e91a82a}, fpelt{0xf7134500e0140fed, 0x38ecf9009337cf40}},
+			fp2elt{fpelt{0xe66158bde7063f5f, 0x5c4497950826ca6b}, fpelt{0x37c4f44c035d10be, 0x71f53c945df995e5}},
+		},
+		{
+			fp2elt{fpelt{0x49f88a9b5cde8e63, 0x0b472d167cd5406f}, fpelt{0xd2b0ec8479083dc4, 0x7b31ae355781b695}},
+			fp2elt{fpelt{0xa39e1e3cc5b32e61, 0x72ac9bacac464030}, fpelt{0xa9b0969945275b61, 0x2b2e52b01637aa2f}},
+		},
+		{
+			fp2elt{fpelt{0xb6077564a321719c, 0x74b8d2e9832abf90}, fpelt{0x2d4f137b86f7c23b, 0x04ce51caa87e496a}},
+			fp2elt{fpelt{0xa39e1e3cc5b32e61, 0x72ac9bacac464030}, fpelt{0xa9b0969945275b61, 0x2b2e52b01637aa2f}},
+		},
+		{
+			fp2elt{fpelt{0x670e161f3191be2e, 0x02fd7f9ed8495c3a}, fpelt{0x0a579aa0f3cecdc4, 0x5f30db0fed597acc}},
+			fp2elt{fpelt{0x619fac301db3e861, 0x755755b218a93db2}, fpelt{0x14b776afb9cc584e, 0x5ca0765173b6bc0a}},
+		},
+		{
+			fp2elt{fpelt{0x98f1e9e0ce6e41d1, 0x7d02806127b6a3c5}, fpelt{0xf5a8655f0c31323b, 0x20cf24f012a68533}},
+			fp2elt{fpelt{0x619fac301db3e861, 0x755755b218a93db2}, fpelt{0x14b776afb9cc584e, 0x5ca0765173b6bc0a}},
+		},
+		{
+			fp2elt{fpelt{0xbb93562a93ce8f80, 0x2e65e96ad8deb857}, fpelt{0x54f1ea7a0c03b0bc, 0x30672d858b4bfcc0}},
+			fp2elt{fpelt{0xfba0831e6a493163, 0x09b0b08a9a8fb137}, fpelt{0xcd736ee892460d1b, 0x6b04ba38ba20c5f2}},
+		},
+		{
+			fp2elt{fpelt{0x446ca9d56c31707f, 0x519a1695272147a8}, fpelt{0xab0e1585f3fc4f43, 0x4f98d27a74b4033f}},
+			fp2elt{fpelt{0xfba0831e6a493163, 0x09b0b08a9a8fb137}, fpelt{0xcd736ee892460d1b, 0x6b04ba38ba20c5f2}},
+		},
+		{
+			fp2elt{fpelt{0xae1a4063b647abb3, 0x17e70e8eab5845e3}, fpelt{0x1a8f2c18f8cb20a3, 0x5ae1004e4c5f3990}},
+			fp2elt{fpelt{0xe249ae45ddff8d68, 0x02daa73dc922cdab}, fpelt{0x88c881c4fff63136, 0x2e88ebf04624a157}},
+		},
+		{
+			fp2elt{fpelt{0x51e5bf9c49b8544c, 0x6818f17154a7ba1c}, fpelt{0xe570d3e70734df5c, 0x251effb1b3a0c66f}},
+			fp2elt{fpelt{0xe249ae45ddff8d68, 0x02daa73dc922cdab}, fpelt{0x88c881c4fff63136, 0x2e88ebf04624a157}},
+		},
+		{
+			fp2elt{fpelt{0xfece2d94d0ba528e, 0x12fa2d17772e32e6}, fpelt{0x4d54db672f45a2b5, 0x12883f829c9e8add}},
+			fp2elt{fpelt{0x3566571053bb0969, 0x7d081b12760912eb}, fpelt{0xa3a379f915b9c203, 0x2c148c94bd40041f}},
+		},
+		{
+			fp2elt{fpelt{0x0131d26b2f45ad71, 0x6d05d2e888d1cd19}, fpelt{0xb2ab2498d0ba5d4a, 0x6d77c07d63617522}},
+			fp2elt{fpelt{0x3566571053bb0969, 0x7d081b12760912eb}, fpelt{0xa3a379f915b9c203, 0x2c148c94bd40041f}},
+		},
+		{
+			fp2elt{fpelt{0x28b200af9c19997d, 0x09c0761ed6b68c9e}, fpelt{0x1ea2b43b5121a22e, 0x515854b6d19cc2da}},
+			fp2elt{fpelt{0xe71c3bf60427e56a, 0x0854c6582766f3f5}, fpelt{0x3c9b73c751b4b192, 0x1318020702de23bc}},
+		},
+		{
+			fp2elt{fpelt{0xd74dff5063e66682, 0x763f89e129497361}, fpelt{0xe15d4bc4aede5dd1, 0x2ea7ab492e633d25}},
+			fp2elt{fpelt{0xe71c3bf60427e56a, 0x0854c6582766f3f5}, fpelt{0x3c9b73c751b4b192, 0x1318020702de23bc}},
+		},
+		{
+			fp2elt{fpelt{0x06456282995d16d6, 0x3b6d0f77e00f125a}, fpelt{0x4927e0fcf03148a9, 0x044f5c04f9e1817e}},
+			fp2elt{fpelt{0x40a1eff318d0486d, 0x4dba14dfb67033da}, fpelt{0xeb2f61d809bbc075, 0x0bda5dd35b06cd16}},
+		},
+		{
+			fp2elt{fpelt{0xf9ba9d7d66a2e929, 0x4492f0881ff0eda5}, fpelt{0xb6d81f030fceb756, 0x7bb0a3fb061e7e81}},
+			fp2elt{fpelt{0x40a1eff318d0486d, 0x4dba14dfb67033da}, fpelt{0xeb2f61d809bbc075, 0x0bda5dd35b06cd16}},
+		},
+		{
+			fp2elt{fpelt{0xc9bf6f68f851e747, 0x270d5e1bb47613e4}, fpelt{0xe44c0a9c344ea44b, 0x13aeb22058009c4f}},
+			fp2elt{fpelt{0xfd9bda1df6fa736d, 0x4b9d3edaa72baffb}, fpelt{0x01f1ab0c0127491a, 0x554e35b6794294e1}},
+		},
+		{
+			fp2elt{fpelt{0x3640909707ae18b8, 0x58f2a1e44b89ec1b}, fpelt{0x1bb3f563cbb15bb4, 0x6c514ddfa7ff63b0}},
+			fp2elt{fpelt{0xfd9bda1df6fa736d, 0x4b9d3edaa72baffb}, fpelt{0x01f1ab0c0127491a, 0x554e35b6794294e1}},
+		},
+		{
+			fp2elt{fpelt{0x49cdac2ab065ae24, 0x29bf89ff8d2a9ab4}, fpelt{0xc82b257ec949174d, 0x1e35d12b5d914be4}},
+			fp2elt{fpelt{0x43d0ed0bcd69df6e, 0x454c48f13e9d8b7a}, fpelt{0x170cce2525e01b89, 0x6ae3f5aab97f9375}},
+		},
+		{
+			fp2elt{fpelt{0xb63253d54f9a51db, 0x5640760072d5654b}, fpelt{0x37d4da8136b6e8b2, 0x61ca2ed4a26eb41b}},
+			fp2elt{fpelt{0x43d0ed0bcd69df6e, 0x454c48f13e9d8b7a}, fpelt{0x170cce2525e01b89, 0x6ae3f5aab97f9375}},
+		},
+		{
+			fp2elt{fpelt{0x995ca3e337c6d246, 0x190fd965924de9c6}, fpelt{0x7f0561a342b2efd5, 0x2cdb29a15c8ff663}},
+			fp2elt{fpelt{0x896e9e305798d770, 0x62f20983304cacd2}, fpelt{0xda28a77c6eba3bf6, 0x7629f7f629065e7d}},
+		},
+		{
+			fp2elt{fpelt{0x66a35c1cc8392db9, 0x66f0269a6db21639}, fpelt{0x80fa9e5cbd4d102a, 0x5324d65ea370099c}},
+			fp2elt{fpelt{0x896e9e305798d770, 0x62f20983304cacd2}, fpelt{0xda28a77c6eba3bf6, 0x7629f7f629065e7d}},
+		},
+		{
+			fp2elt{fpelt{0x039edded0415d2a4, 0x2e5c46b5ccc5c5d1}, fpelt{0x8bde3c9ff6007a88, 0x6cd203f93927e295}},
+			fp2elt{fpelt{0x7555c01b4488f270, 0x26b9b5f2a9b058b6}, fpelt{0xf02ac9c6c9de9c47, 0x50bd416e87980046}},
+		},
+		{
+			fp2elt{fpelt{0xfc612212fbea2d5b, 0x51a3b94a333a3a2e}, fpelt{0x7421c36009ff8577, 0x132dfc06c6d81d6a}},
+			fp2elt{fpelt{0x7555c01b4488f270, 0x26b9b5f2a9b058b6}, fpelt{0xf02ac9c6c9de9c47, 0x50bd416e87980046}},
+		},
+		{
+			fp2elt{fpelt{0x42e49595f2db3a1a, 0x3cae367c8099edca}, fpelt{0x51f7e05e24a63ac0, 0x5d369b475940e11f}},
+			fp2elt{fpelt{0x3acbb6f9a0d58974, 0x2c339a351f104e03}, fpelt{0xe0a71205e59f4bd9, 0x702268a1d81295a7}},
+		},
+		{
+			fp2elt{fpelt{0xbd1b6a6a0d24c5e5, 0x4351c9837f661235}, fpelt{0xae081fa1db59c53f, 0x22c964b8a6bf1ee0}},
+			fp2elt{fpelt{0x3acbb6f9a0d58974, 0x2c339a351f104e03}, fpelt{0xe0a71205e59f4bd9, 0x702268a1d81295a7}},
+		},
+		{
+			fp2elt{fpelt{0xf6f4e6ddc59cfcc9, 0x193f5bea1828e420}, fpelt{0x15f842796586e659, 0x3df3019e9b53c40a}},
+			fp2elt{fpelt{0x4e7e2ac0c369c575, 0x4fd6336a001fcf95}, fpelt{0x42c884db0948095d, 0x3073649f6d79c7d2}},
+		},
+		{
+			fp2elt{fpelt{0x090b19223a630336, 0x66c0a415e7d71bdf}, fpelt{0xea07bd869a7919a6, 0x420cfe6164ac3bf5}},
+			fp2elt{fpelt{0x4e7e2ac0c369c575, 0x4fd6336a001fcf95}, fpelt{0x42c884db0948095d, 0x3073649f6d79c7d2}},
+		},
+		{
+			fp2elt{fpelt{0xdf76f4c71f1a2f0a, 0x02c7d57aa6743ad5}, fpelt{0x817c4753be683183, 0x6a6c5ab1a7c24791}},
+			fp2elt{fpelt{0x463fd1abc5e94377, 0x78631a4301b7318d}, fpelt{0x0f346d2e3b6eca79, 0x61c0b96f7946be01}},
+		},
+		{
+			fp2elt{fpelt{0x20890b38e0e5d0f5, 0x7d382a85598bc52a}, fpelt{0x7e83b8ac4197ce7c, 0x1593a54e583db86e}},
+			fp2elt{fpelt{0x463fd1abc5e94377, 0x78631a4301b7318d}, fpelt{0x0f346d2e3b6eca79, 0x61c0b96f7946be01}},
+		},
+		{
+			fp2elt{fpelt{0x0fd53639362163b8, 0x2f42be917867ffb9}, fpelt{0x7555c01b4488f270, 0x26b9b5f2a9b058b6}},
+			fp2elt{fpelt{0x7421c36009ff8577, 0x132dfc06c6d81d6a}, fpelt{0x039edded0415d2a4, 0x2e5c46b5ccc5c5d1}},
+		},
+		{
+			fp2elt{fpelt{0xf02ac9c6c9de9c47, 0x50bd416e87980046}, fpelt{0x8aaa3fe4bb770d8f, 0x59464a0d564fa749}},
+			fp2elt{fpelt{0x7421c36009ff8577, 0x132dfc06c6d81d6a}, fpelt{0x039edded0415d2a4, 0x2e5c46b5ccc5c5d1}},
+		},
+		{
+			fp2elt{fpelt{0x3d7b93050fc4369b, 0x159db63c49e4415b}, fpelt{0xa2ba2b9c59d770f8, 0x376985fdc464b3d4}},
+			fp2elt{fpelt{0x1ba566106a8ff977, 0x7ea2edd061ec3b55}, fpelt{0x975ad727948719d8, 0x48b3c09493c11b2f}},
+		},
+		{
+			fp2elt{fpelt{0xc2846cfaf03bc964, 0x6a6249c3b61bbea4}, fpelt{0x5d45d463a6288f07, 0x48967a023b9b4c2b}},
+			fp2elt{fpelt{0x1ba566106a8ff977, 0x7ea2edd061ec3b55}, fpelt{0x975ad727948719d8, 0x48b3c09493c11b2f}},
+		},
+		{
+			fp2elt{fpelt{0x56ff5a886c8abe8c, 0x2bcc52b2c4f41401}, fpelt{0x9a88e5a6b982ca32, 0x2ecd5669cdcfc954}},
+			fp2elt{fpelt{0x810c0f0f4aa8a17b, 0x3e8849360050af33}, fpelt{0x0b8efa11230f3540, 0x71fdcc6f95520cf9}},
+		},
+		{
+			fp2elt{fpelt{0xa900a57793754173, 0x5433ad4d3b0bebfe}, fpelt{0x65771a59467d35cd, 0x5132a996323036ab}},
+			fp2elt{fpelt{0x810c0f0f4aa8a17b, 0x3e8849360050af33}, fpelt{0x0b8efa11230f3540, 0x71fdcc6f95520cf9}},
+		},
+		{
+			fp2elt{fpelt{0xf0cb92d1c4913586, 0x1e3f469086b941fe}, fpelt{0x463fd1abc5e94377, 0x78631a4301b7318d}},
+			fp2elt{fpelt{0x7e83b8ac4197ce7c, 0x1593a54e583db86e}, fpelt{0xdf76f4c71f1a2f0a, 0x02c7d57aa6743ad5}},
+		},
+		{
+			fp2elt{fpelt{0x0f346d2e3b6eca79, 0x61c0b96f7946be01}, fpelt{0xb9c02e543a16bc88, 0x079ce5bcfe48ce72}},
+			fp2elt{fpelt{0x7e83b8ac4197ce7c, 0x1593a54e583db86e}, fpelt{0xdf76f4c71f1a2f0a, 0x02c7d57aa6743ad5}},
+		},
+		{
+			fp2elt{fpelt{0x8acb67f99c80560f, 0x129aa707e3726825}, fpelt{0x3184f05ccdf1d030, 0x1f28d59de76b2056}},
+			fp2elt{fpelt{0x5324f25b764c7b7e, 0x6d1bb95d24c0443c}, fpelt{0xdd57cd1f18046539, 0x1c1ef3ffafcd3359}},
+		},
+		{
+			fp2elt{fpelt{0x75349806637fa9f0, 0x6d6558f81c8d97da}, fpelt{0xce7b0fa3320e2fcf, 0x60d72a621894dfa9}},
+			fp2elt{fpelt{0x5324f25b764c7b7e, 0x6d1bb95d24c0443c}, fpelt{0xdd57cd1f18046539, 0x1c1ef3ffafcd3359}},
+		},
+		{
+			fp2elt{fpelt{0x8acb67f99c80560f, 0x129aa707e3726825}, fpelt{0x3184f05ccdf1d030, 0x1f28d59de76b2056}},
+			fp2elt{fpelt{0xacdb0da489b38481, 0x12e446a2db3fbbc3}, fpelt{0x22a832e0e7fb9ac6, 0x63e10c005032cca6}},
+		},
+		{
+			fp2elt{fpelt{0x75349806637fa9f0, 0x6d6558f81c8d97da}, fpelt{0xce7b0fa3320e2fcf, 0x60d72a621894dfa9}},
+			fp2elt{fpelt{0xacdb0da489b38481, 0x12e446a2db3fbbc3}, fpelt{0x22a832e0e7fb9ac6, 0x63e10c005032cca6}},
+		},
+		{
+			fp2elt{fpelt{0xf0cb92d1c4913586, 0x1e3f469086b941fe}, fpelt{0x463fd1abc5e94377, 0x78631a4301b7318d}},
+			fp2elt{fpelt{0x817c4753be683183, 0x6a6c5ab1a7c24791}, fpelt{0x20890b38e0e5d0f5, 0x7d382a85598bc52a}},
+		},
+		{
+			fp2elt{fpelt{0x0f346d2e3b6eca79, 0x61c0b96f7946be01}, fpelt{0xb9c02e543a16bc88, 0x079ce5bcfe48ce72}},
+			fp2elt{fpelt{0x817c4753be683183, 0x6a6c5ab1a7c24791}, fpelt{0x20890b38e0e5d0f5, 0x7d382a85598bc52a}},
+		},
+		{
+			fp2elt{fpelt{0x56ff5a886c8abe8c, 0x2bcc52b2c4f41401}, fpelt{0x9a88e5a6b982ca32, 0x2ecd5669cdcfc954}},
+			fp2elt{fpelt{0x7ef3f0f0b5575e84, 0x4177b6c9ffaf50cc}, fpelt{0xf47105eedcf0cabf, 0x0e0233906aadf306}},
+		},
+		{
+			fp2elt{fpelt{0xa900a57793754173, 0x5433ad4d3b0bebfe}, fpelt{0x65771a59467d35cd, 0x5132a996323036ab}},
+			fp2elt{fpelt{0x7ef3f0f0b5575e84, 0x4177b6c9ffaf50cc}, fpelt{0xf47105eedcf0cabf, 0x0e0233906aadf306}},
+		},
+		{
+			fp2elt{fpelt{0x3d7b93050fc4369b, 0x159db63c49e4415b}, fpelt{0xa2ba2b9c59d770f8, 0x376985fdc464b3d4}},
+			fp2elt{fpelt{0xe45a99ef95700688, 0x015d122f9e13c4aa}, fpelt{0x68a528d86b78e627, 0x374c3f6b6c3ee4d0}},
+		},
+		{
+			fp2elt{fpelt{0xc2846cfaf03bc964, 0x6a6249c3b61bbea4}, fpelt{0x5d45d463a6288f07, 0x48967a023b9b4c2b}},
+			fp2elt{fpelt{0xe45a99ef95700688, 0x015d122f9e13c4aa}, fpelt{0x68a528d86b78e627, 0x374c3f6b6c3ee4d0}},
+		},
+		{
+			fp2elt{fpelt{0x0fd53639362163b8, 0x2f42be917867ffb9}, fpelt{0x7555c01b4488f270, 0x26b9b5f2a9b058b6}},
+			fp2elt{fpelt{0x8bde3c9ff6007a88, 0x6cd203f93927e295}, fpelt{0xfc612212fbea2d5b, 0x51a3b94a333a3a2e}},
+		},
+		{
+			fp2elt{fpelt{0xf02ac9c6c9de9c47, 0x50bd416e87980046}, fpelt{0x8aaa3fe4bb770d8f, 0x59464a0d564fa749}},
+			fp2elt{fpelt{0x8bde3c9ff6007a88, 0x6cd203f93927e295}, fpelt{0xfc612212fbea2d5b, 0x51a3b94a333a3a2e}},
+		},
+		{
+			fp2elt{fpelt{0xdf76f4c71f1a2f0a, 0x02c7d57aa6743ad5}, fpelt{0x817c4753be683183, 0x6a6c5ab1a7c24791}},
+			fp2elt{fpelt{0xb9c02e543a16bc88, 0x079ce5bcfe48ce72}, fpelt{0xf0cb92d1c4913586, 0x1e3f469086b941fe}},
+		},
+		{
+			fp2elt{fpelt{0x20890b38e0e5d0f5, 0x7d382a85598bc52a}, fpelt{0x7e83b8ac4197ce7c, 0x1593a54e583db86e}},
+			fp2elt{fpelt{0xb9c02e543a16bc88, 0x079ce5bcfe48ce72}, fpelt{0xf0cb92d1c4913586, 0x1e3f469086b941fe}},
+		},
+		{
+			fp2elt{fpelt{0xf6f4e6ddc59cfcc9, 0x193f5bea1828e420}, fpelt{0x15f842796586e659, 0x3df3019e9b53c40a}},
+			fp2elt{fpelt{0xb181d53f3c963a8a, 0x3029cc95ffe0306a}, fpelt{0xbd377b24f6b7f6a2, 0x4f8c9b609286382d}},
+		},
+		{
+			fp2elt{fpelt{0x090b19223a630336, 0x66c0a415e7d71bdf}, fpelt{0xea07bd869a7919a6, 0x420cfe6164ac3bf5}},
+			fp2elt{fpelt{0xb181d53f3c963a8a, 0x3029cc95ffe0306a}, fpelt{0xbd377b24f6b7f6a2, 0x4f8c9b609286382d}},
+		},
+		{
+			fp2elt{fpelt{0x42e49595f2db3a1a, 0x3cae367c8099edca}, fpelt{0x51f7e05e24a63ac0, 0x5d369b475940e11f}},
+			fp2elt{fpelt{0xc53449065f2a768b, 0x53cc65cae0efb1fc}, fpelt{0x1f58edfa1a60b426, 0x0fdd975e27ed6a58}},
+		},
+		{
+			fp2elt{fpelt{0xbd1b6a6a0d24c5e5, 0x4351c9837f661235}, fpelt{0xae081fa1db59c53f, 0x22c964b8a6bf1ee0}},
+			fp2elt{fpelt{0xc53449065f2a768b, 0x53cc65cae0efb1fc}, fpelt{0x1f58edfa1a60b426, 0x0fdd975e27ed6a58}},
+		},
+		{
+			fp2elt{fpelt{0x039edded0415d2a4, 0x2e5c46b5ccc5c5d1}, fpelt{0x8bde3c9ff6007a88, 0x6cd203f93927e295}},
+			fp2elt{fpelt{0x8aaa3fe4bb770d8f, 0x59464a0d564fa749}, fpelt{0x0fd53639362163b8, 0x2f42be917867ffb9}},
+		},
+		{
+			fp2elt{fpelt{0xfc612212fbea2d5b, 0x51a3b94a333a3a2e}, fpelt{0x7421c36009ff8577, 0x132dfc06c6d81d6a}},
+			fp2elt{fpelt{0x8aaa3fe4bb770d8f, 0x59464a0d564fa749}, fpelt{0x0fd53639362163b8, 0x2f42be917867ffb9}},
+		},
+		{
+			fp2elt{fpelt{0x995ca3e337c6d246, 0x190fd965924de9c6}, fpelt{0x7f0561a342b2efd5, 0x2cdb29a15c8ff663}},
+			fp2elt{fpelt{0x769161cfa867288f, 0x1d0df67ccfb3532d}, fpelt{0x25d758839145c409, 0x09d60809d6f9a182}},
+		},
+		{
+			fp2elt{fpelt{0x66a35c1cc8392db9, 0x66f0269a6db21639}, fpelt{0x80fa9e5cbd4d102a, 0x5324d65ea370099c}},
+			fp2elt{fpelt{0x769161cfa867288f, 0x1d0df67ccfb3532d}, fpelt{0x25d758839145c409, 0x09d60809d6f9a182}},
+		},
+		{
+			fp2elt{fpelt{0x49cdac2ab065ae24, 0x29bf89ff8d2a9ab4}, fpelt{0xc82b257ec949174d, 0x1e35d12b5d914be4}},
+			fp2elt{fpelt{0xbc2f12f432962091, 0x3ab3b70ec1627485}, fpelt{0xe8f331dada1fe476, 0x151c0a5546806c8a}},
+		},
+		{
+			fp2elt{fpelt{0xb63253d54f9a51db, 0x5640760072d5654b}, fpelt{0x37d4da8136b6e8b2, 0x61ca2ed4a26eb41b}},
+			fp2elt{fpelt{0xbc2f12f432962091, 0x3ab3b70ec1627485}, fpelt{0xe8f331dada1fe476, 0x151c0a5546806c8a}},
+		},
+		{
+			fp2elt{fpelt{0xc9bf6f68f851e747, 0x270d5e1bb47613e4}, fpelt{0xe44c0a9c344ea44b, 0x13aeb22058009c4f}},
+			fp2elt{fpelt{0x026425e209058c92, 0x3462c12558d45004}, fpelt{0xfe0e54f3fed8b6e5, 0x2ab1ca4986bd6b1e}},
+		},
+		{
+			fp2elt{fpelt{0x3640909707ae18b8, 0x58f2a1e44b89ec1b}, fpelt{0x1bb3f563cbb15bb4, 0x6c514ddfa7ff63b0}},
+			fp2elt{fpelt{0x026425e209058c92, 0x3462c12558d45004}, fpelt{0xfe0e54f3fed8b6e5, 0x2ab1ca4986bd6b1e}},
+		},
+		{
+			fp2elt{fpelt{0x06456282995d16d6, 0x3b6d0f77e00f125a}, fpelt{0x4927e0fcf03148a9, 0x044f5c04f9e1817e}},
+			fp2elt{fpelt{0xbf5e100ce72fb792, 0x3245eb20498fcc25}, fpelt{0x14d09e27f6443f8a, 0x7425a22ca4f932e9}},
+		},
+		{
+			fp2elt{fpelt{0xf9ba9d7d66a2e929, 0x4492f0881ff0eda5}, fpelt{0xb6d81f030fceb756, 0x7bb0a3fb061e7e81}},
+			fp2elt{fpelt{0xbf5e100ce72fb792, 0x3245eb20498fcc25}, fpelt{0x14d09e27f6443f8a, 0x7425a22ca4f932e9}},
+		},
+		{
+			fp2elt{fpelt{0x28b200af9c19997d, 0x09c0761ed6b68c9e}, fpelt{0x1ea2b43b5121a22e, 0x515854b6d19cc2da}},
+			fp2elt{fpelt{0x18e3c409fbd81a95, 0x77ab39a7d8990c0a}, fpelt{0xc3648c38ae4b4e6d, 0x6ce7fdf8fd21dc43}},
+		},
+		{
+			fp2elt{fpelt{0xd74dff5063e66682, 0x763f89e129497361}, fpelt{0xe15d4bc4aede5dd1, 0x2ea7ab492e633d25}},
+			fp2elt{fpelt{0x18e3c409fbd81a95, 0x77ab39a7d8990c0a}, fpelt{0xc3648c38ae4b4e6d, 0x6ce7fdf8fd21dc43}},
+		},
+		{
+			fp2elt{fpelt{0xfece2d94d0ba528e, 0x12fa2d17772e32e6}, fpelt{0x4d54db672f45a2b5, 0x12883f829c9e8add}},
+			fp2elt{fpelt{0xca99a8efac44f696, 0x02f7e4ed89f6ed14}, fpelt{0x5c5c8606ea463dfc, 0x53eb736b42bffbe0}},
+		},
+		{
+			fp2elt{fpelt{0x0131d26b2f45ad71, 0x6d05d2e888d1cd19}, fpelt{0xb2ab2498d0ba5d4a, 0x6d77c07d63617522}},
+			fp2elt{fpelt{0xca99a8efac44f696, 0x02f7e4ed89f6ed14}, fpelt{0x5c5c8606ea463dfc, 0x53eb736b42bffbe0}},
+		},
+		{
+			fp2elt{fpelt{0xae1a4063b647abb3, 0x17e70e8eab5845e3}, fpelt{0x1a8f2c18f8cb20a3, 0x5ae1004e4c5f3990}},
+			fp2elt{fpelt{0x1db651ba22007297, 0x7d2558c236dd3254}, fpelt{0x77377e3b0009cec9, 0x5177140fb9db5ea8}},
+		},
+		{
+			fp2elt{fpelt{0x51e5bf9c49b8544c, 0x6818f17154a7ba1c}, fpelt{0xe570d3e70734df5c, 0x251effb1b3a0c66f}},
+			fp2elt{fpelt{0x1db651ba22007297, 0x7d2558c236dd3254}, fpelt{0x77377e3b0009cec9, 0x5177140fb9db5ea8}},
+		},
+		{
+			fp2elt{fpelt{0xbb93562a93ce8f80, 0x2e65e96ad8deb857}, fpelt{0x54f1ea7a0c03b0bc, 0x30672d858b4bfcc0}},
+			fp2elt{fpelt{0x045f7ce195b6ce9c, 0x764f4f7565704ec8}, fpelt{0x328c91176db9f2e4, 0x14fb45c745df3a0d}},
+		},
+		{
+			fp2elt{fpelt{0x446ca9d56c31707f, 0x519a1695272147a8}, fpelt{0xab0e1585f3fc4f43, 0x4f98d27a74b4033f}},
+			fp2elt{fpelt{0x045f7ce195b6ce9c, 0x764f4f7565704ec8}, fpelt{0x328c91176db9f2e4, 0x14fb45c745df3a0d}},
+		},
+		{
+			fp2elt{fpelt{0x670e161f3191be2e, 0x02fd7f9ed8495c3a}, fpelt{0x0a579aa0f3cecdc4, 0x5f30db0fed597acc}},
+			fp2elt{fpelt{0x9e6053cfe24c179e, 0x0aa8aa4de756c24d}, fpelt{0xeb4889504633a7b1, 0x235f89ae8c4943f5}},
+		},
+		{
+			fp2elt{fpelt{0x98f1e9e0ce6e41d1, 0x7d02806127b6a3c5}, fpelt{0xf5a8655f0c31323b, 0x20cf24f012a68533}},
+			fp2elt{fpelt{0x9e6053cfe24c179e, 0x0aa8aa4de756c24d}, fpelt{0xeb4889504633a7b1, 0x235f89ae8c4943f5}},
+		},
+		{
+			fp2elt{fpelt{0x49f88a9b5cde8e63, 0x0b472d167cd5406f}, fpelt{0xd2b0ec8479083dc4, 0x7b31ae355781b695}},
+			fp2elt{fpelt{0x5c61e1c33a4cd19e, 0x0d53645353b9bfcf}, fpelt{0x564f6966bad8a49e, 0x54d1ad4fe9c855d0}},
+		},
+		{
+			fp2elt{fpelt{0xb6077564a321719c, 0x74b8d2e9832abf90}, fpelt{0x2d4f137b86f7c23b, 0x04ce51caa87e496a}},
+			fp2elt{fpelt{0x5c61e1c33a4cd19e, 0x0d53645353b9bfcf}, fpelt{0x564f6966bad8a49e, 0x54d1ad4fe9c855d0}},
+		},
+		{
+			fp2elt{fpelt{0x11c4f540803d804e, 0x30508c85e16e57d5}, fpelt{0x08ecbaff1febf012, 0x471306ff6cc830bf}},
+			fp2elt{fpelt{0x199ea74218f9c0a0, 0x23bb686af7d93594}, fpelt{0xc83b0bb3fca2ef41, 0x0e0ac36ba2066a1a}},
+		},
+		{
+			fp2elt{fpelt{0xee3b0abf7fc27fb1, 0x4faf737a1e91a82a}, fpelt{0xf7134500e0140fed, 0x38ecf9009337cf40}},
+			fp2elt{fpelt{0x199ea74218f9c0a0, 0x23bb686af7d93594}, fpelt{0xc83b0bb3fca2ef41, 0x0e0ac36ba2066a1a}},
+		},
+		{
+			fp2elt{fpelt{0xbd3f7bdf73de8539, 0x1b334b27e9d13e13}, fpelt{0xe447bf60d2e1753a, 0x4f05d01f082a70e9}},
+			fp2elt{fpelt{0xc4a73239c918c9a0, 0x71c9d0764cc727d0}, fpelt{0xbcdcf58947f92ba5, 0x61202da522429dee}},
+		},
+		{
+			fp2elt{fpelt{0x42c084208c217ac6, 0x64ccb4d8162ec1ec}, fpelt{0x1bb8409f2d1e8ac5, 0x30fa2fe0f7d58f16}},
+			fp2elt{fpelt{0xc4a73239c918c9a0, 0x71c9d0764cc727d0}, fpelt{0xbcdcf58947f92ba5, 0x61202da522429dee}},
+		},
+		{
+			fp2elt{fpelt{0x88c881c4fff63136, 0x2e88ebf04624a157}, fpelt{0x1db651ba22007297, 0x7d2558c236dd3254}},
+			fp2elt{fpelt{0x1a8f2c18f8cb20a3, 0x5ae1004e4c5f3990}, fpelt{0x51e5bf9c49b8544c, 0x6818f17154a7ba1c}},
+		},
+		{
+			fp2elt{fpelt{0x77377e3b0009cec9, 0x5177140fb9db5ea8}, fpelt{0xe249ae45ddff8d68, 0x02daa73dc922cdab}},
+			fp2elt{fpelt{0x1a8f2c18f8cb20a3, 0x5ae1004e4c5f3990}, fpelt{0x51e5bf9c49b8544c, 0x6818f17154a7ba1c}},
+		},
+		{
+			fp2elt{fpelt{0xb6c2099544e20ea7, 0x234c744272683e29}, fpelt{0x00aaf2d88a8755fb, 0x6538deaa71169889}},
+			fp2elt{fpelt{0xd1e826cf9acc76a4, 0x6fa24b874a66616e}, fpelt{0xd54baa2ffb5ebf90, 0x0f2e6cbac6991d1d}},
+		},
+		{
+			fp2elt{fpelt{0x493df66abb1df158, 0x5cb38bbd8d97c1d6}, fpelt{0xff550d277578aa04, 0x1ac721558ee96776}},
+			fp2elt{fpelt{0xd1e826cf9acc76a4, 0x6fa24b874a66616e}, fpelt{0xd54baa2ffb5ebf90, 0x0f2e6cbac6991d1d}},
+		},
+		{
+			fp2elt{fpelt{0x8855848f6c641ffc, 0x3bd16e4b3e9df7e1}, fpelt{0x267186643dd8f61c, 0x6fe7c72f17611147}},
+			fp2elt{fpelt{0x591ac4402fd01aa5, 0x7a755e911947c379}, fpelt{0x05ff47d19e712c2f, 0x02e3b4ecaa3c8abd}},
+		},
+		{
+			fp2elt{fpelt{0x77aa7b70939be003, 0x442e91b4c162081e}, fpelt{0xd98e799bc22709e3, 0x101838d0e89eeeb8}},
+			fp2elt{fpelt{0x591ac4402fd01aa5, 0x7a755e911947c379}, fpelt{0x05ff47d19e712c2f, 0x02e3b4ecaa3c8abd}},
+		},
+		{
+			fp2elt{fpelt{0x379288d046f64cce, 0x2219bcb951a89211}, fpelt{0xe540eb7198aa5604, 0x50694f379cab4551}},
+			fp2elt{fpelt{0xd07753ca466be0a5, 0x02987afd2e667484}, fpelt{0x6c9d5405f6b01e72, 0x19b4cee07aa345ea}},
+		},
+		{
+			fp2elt{fpelt{0xc86d772fb909b331, 0x5de64346ae576dee}, fpelt{0x1abf148e6755a9fb, 0x2f96b0c86354baae}},
+			fp2elt{fpelt{0xd07753ca466be0a5, 0x02987afd2e667484}, fpelt{0x6c9d5405f6b01e72, 0x19b4cee07aa345ea}},
+		},
+		{
+			fp2elt{fpelt{0x42c884db0948095d, 0x3073649f6d79c7d2}, fpelt{0xb181d53f3c963a8a, 0x3029cc95ffe0306a}},
+			fp2elt{fpelt{0xea07bd869a7919a6, 0x420cfe6164ac3bf5}, fpelt{0xf6f4e6ddc59cfcc9, 0x193f5bea1828e420}},
+		},
+		{
+			fp2elt{fpelt{0xbd377b24f6b7f6a2, 0x4f8c9b609286382d}, fpelt{0x4e7e2ac0c369c575, 0x4fd6336a001fcf95}},
+			fp2elt{fpelt{0xea07bd869a7919a6, 0x420cfe6164ac3bf5}, fpelt{0xf6f4e6ddc59cfcc9, 0x193f5bea1828e420}},
+		},
+		{
+			fp2elt{fpelt{0xf91cf409d5cdbece, 0x315e6ca16bd2e816}, fpelt{0xcd009165afd2c128, 0x7509cfa1d6c1c043}},
+			fp2elt{fpelt{0xfb7c9234f437e7a6, 0x6552c0e17da6defa}, fpelt{0xe3b209f6d0528e20, 0x105ba2865134c01d}},
+		},
+		{
+			fp2elt{fpelt{0x06e30bf62a324131, 0x4ea1935e942d17e9}, fpelt{0x32ff6e9a502d3ed7, 0x0af6305e293e3fbc}},
+			fp2elt{fpelt{0xfb7c9234f437e7a6, 0x6552c0e17da6defa}, fpelt{0xe3b209f6d0528e20, 0x105ba2865134c01d}},
+		},
+		{
+			fp2elt{fpelt{0x38a312c67fc23bad, 0x19e6d8d8555536d5}, fpelt{0x7067f77e981fafe7, 0x2e58777b7777a081}},
+			fp2elt{fpelt{0x337c3e5f11240ba8, 0x4e5ebe04daa29ac6}, fpelt{0x456205233d88d549, 0x4bf416db5c7f8def}},
+		},
+		{
+			fp2elt{fpelt{0xc75ced39803dc452, 0x66192727aaaac92a}, fpelt{0x8f98088167e05018, 0x51a7888488885f7e}},
+			fp2elt{fpelt{0x337c3e5f11240ba8, 0x4e5ebe04daa29ac6}, fpelt{0x456205233d88d549, 0x4bf416db5c7f8def}},
+		},
+		{
+			fp2elt{fpelt{0xeb2f61d809bbc075, 0x0bda5dd35b06cd16}, fpelt{0xbf5e100ce72fb792, 0x3245eb20498fcc25}},
+			fp2elt{fpelt{0x4927e0fcf03148a9, 0x044f5c04f9e1817e}, fpelt{0xf9ba9d7d66a2e929, 0x4492f0881ff0eda5}},
+		},
+		{
+			fp2elt{fpelt{0x14d09e27f6443f8a, 0x7425a22ca4f932e9}, fpelt{0x40a1eff318d0486d, 0x4dba14dfb67033da}},
+			fp2elt{fpelt{0x4927e0fcf03148a9, 0x044f5c04f9e1817e}, fpelt{0xf9ba9d7d66a2e929, 0x4492f0881ff0eda5}},
+		},
+		{
+			fp2elt{fpelt{0xb2d76ec171fe470e, 0x0d6995a8ed492a18}, fpelt{0x2b63c210cb8c37cf, 0x59d3c31a731b30b3}},
+			fp2elt{fpelt{0x415a03c7d4c664aa, 0x3e3f823b64ac6798}, fpelt{0xe754a33c17660786, 0x7af7091a438c7249}},
+		},
+		{
+			fp2elt{fpelt{0x4d28913e8e01b8f1, 0x72966a5712b6d5e7}, fpelt{0xd49c3def3473c830, 0x262c3ce58ce4cf4c}},
+			fp2elt{fpelt{0x415a03c7d4c664aa, 0x3e3f823b64ac6798}, fpelt{0xe754a33c17660786, 0x7af7091a438c7249}},
+		},
+		{
+			fp2elt{fpelt{0x276c1e55fbde1a3f, 0x0b79b929abbdaf64}, fpelt{0xd752702565b2dade, 0x292d15ecfd02c25d}},
+			fp2elt{fpelt{0xef4121223be471af, 0x58e501570267ba12}, fpelt{0xe09c24b8e5f51a0e, 0x2c8e320769c4c3ed}},
+		},
+		{
+			fp2elt{fpelt{0xd893e1aa0421e5c0, 0x748646d65442509b}, fpelt{0x28ad8fda9a4d2521, 0x56d2ea1302fd3da2}},
+			fp2elt{fpelt{0xef4121223be471af, 0x58e501570267ba12}, fpelt{0xe09c24b8e5f51a0e, 0x2c8e320769c4c3ed}},
+		},
+		{
+			fp2elt{fpelt{0x8758d5400c11c95d, 0x3f97242266fbceeb}, fpelt{0xc0462de4c4aea830, 0x16c1e52761d69243}},
+			fp2elt{fpelt{0x08a642261ac988b1, 0x7d52f1fa8f104edd}, fpelt{0xb9af5d0c6ae5c074, 0x33a346a7083bcddd}},
+		},
+		{
+			fp2elt{fpelt{0x78a72abff3ee36a2, 0x4068dbdd99043114}, fpelt{0x3fb9d21b3b5157cf, 0x693e1ad89e296dbc}},
+			fp2elt{fpelt{0x08a642261ac988b1, 0x7d52f1fa8f104edd}, fpelt{0xb9af5d0c6ae5c074, 0x33a346a7083bcddd}},
+		},
+		{
+			fp2elt{fpelt{0xacf85a0202896777, 0x1ea80bbbce95efa9}, fpelt{0x1e16e227c1ea4827, 0x2cac7fa9038d578d}},
+			fp2elt{fpelt{0x140097093cdfbab2, 0x58ff3488f3aca3e4}, fpelt{0x0c8eee17e9c7555f, 0x5b9b7834d5328b87}},
+		},
+		{
+			fp2elt{fpelt{0x5307a5fdfd769888, 0x6157f444316a1056}, fpelt{0xe1e91dd83e15b7d8, 0x53538056fc72a872}},
+			fp2elt{fpelt{0x140097093cdfbab2, 0x58ff3488f3aca3e4}, fpelt{0x0c8eee17e9c7555f, 0x5b9b7834d5328b87}},
+		},
+		{
+			fp2elt{fpelt{0x89b0e03fd0825923, 0x24d9ee65ec6b323a}, fpelt{0xfbd86e3d4e7db324, 0x1cdea748f9102bec}},
+			fp2elt{fpelt{0x32447e58ac35e6b2, 0x472d049794de9137}, fpelt{0xb8f97c7a6805ba86, 0x2dd1d1d7563d1c8a}},
+		},
+		{
+			fp2elt{fpelt{0x764f1fc02f7da6dc, 0x5b26119a1394cdc5}, fpelt{0x042791c2b1824cdb, 0x632158b706efd413}},
+			fp2elt{fpelt{0x32447e58ac35e6b2, 0x472d049794de9137}, fpelt{0xb8f97c7a6805ba86, 0x2dd1d1d7563d1c8a}},
+		},
+		{
+			fp2elt{fpelt{0xe8f331dada1fe476, 0x151c0a5546806c8a}, fpelt{0x43d0ed0bcd69df6e, 0x454c48f13e9d8b7a}},
+			fp2elt{fpelt{0x37d4da8136b6e8b2, 0x61ca2ed4a26eb41b}, fpelt{0x49cdac2ab065ae24, 0x29bf89ff8d2a9ab4}},
+		},
+		{
+			fp2elt{fpelt{0x170cce2525e01b89, 0x6ae3f5aab97f9375}, fpelt{0xbc2f12f432962091, 0x3ab3b70ec1627485}},
+			fp2elt{fpelt{0x37d4da8136b6e8b2, 0x61ca2ed4a26eb41b}, fpelt{0x49cdac2ab065ae24, 0x29bf89ff8d2a9ab4}},
+		},
+		{
+			fp2elt{fpelt{0xc1cc983e158cf82d, 0x35b2c84beadd620f}, fpelt{0x08653ef052222d41, 0x21ec6ebcc395c073}},
+			fp2elt{fpelt{0xba8aa588d44e35b4, 0x72ea1c3556c9b6bc}, fpelt{0xd513d5a2d8bf057c, 0x0819ee5d76062217}},
+		},
+		{
+			fp2elt{fpelt{0x3e3367c1ea7307d2, 0x4a4d37b415229df0}, fpelt{0xf79ac10fadddd2be, 0x5e1391433c6a3f8c}},
+			fp2elt{fpelt{0xba8aa588d44e35b4, 0x72ea1c3556c9b6bc}, fpelt{0xd513d5a2d8bf057c, 0x0819ee5d76062217}},
+		},
+		{
+			fp2elt{fpelt{0xfe0e54f3fed8b6e5, 0x2ab1ca4986bd6b1e}, fpelt{0xfd9bda1df6fa736d, 0x4b9d3edaa72baffb}},
+			fp2elt{fpelt{0x1bb3f563cbb15bb4, 0x6c514ddfa7ff63b0}, fpelt{0xc9bf6f68f851e747, 0x270d5e1bb47613e4}},
+		},
+		{
+			fp2elt{fpelt{0x01f1ab0c0127491a, 0x554e35b6794294e1}, fpelt{0x026425e209058c92, 0x3462c12558d45004}},
+			fp2elt{fpelt{0x1bb3f563cbb15bb4, 0x6c514ddfa7ff63b0}, fpelt{0xc9bf6f68f851e747, 0x270d5e1bb47613e4}},
+		},
+		{
+			fp2elt{fpelt{0xa3a379f915b9c203, 0x2c148c94bd40041f}, fpelt{0xca99a8efac44f696, 0x02f7e4ed89f6ed14}},
+			fp2elt{fpelt{0x4d54db672f45a2b5, 0x12883f829c9e8add}, fpelt{0x0131d26b2f45ad71, 0x6d05d2e888d1cd19}},
+		},
+		{
+			fp2elt{fpelt{0x5c5c8606ea463dfc, 0x53eb736b42bffbe0}, fpelt{0x3566571053bb0969, 0x7d081b12760912eb}},
+			fp2elt{fpelt{0x4d54db672f45a2b5, 0x12883f829c9e8add}, fpelt{0x0131d26b2f45ad71, 0x6d05d2e888d1cd19}},
+		},
+		{
+			fp2elt{fpelt{0xe3e6e04b079e10bf, 0x05eeb114acd4461b}, fpelt{0x8123ff22707ba6f5, 0x7755ac53e4d3fba9}},
+			fp2elt{fpelt{0x9f375a75fce46fb6, 0x2c7972da1d1fcf39}, fpelt{0x5433bd68226b0b97, 0x71a48a25af2e8685}},
+		},
+		{
+			fp2elt{fpelt{0x1c191fb4f861ef40, 0x7a114eeb532bb9e4}, fpelt{0x7edc00dd8f84590a, 0x08aa53ac1b2c0456}},
+			fp2elt{fpelt{0x9f375a75fce46fb6, 0x2c7972da1d1fcf39}, fpelt{0x5433bd68226b0b97, 0x71a48a25af2e8685}},
+		},
+		{
+			fp2elt{fpelt{0xcd219ccff4030451, 0x22f3e5c3e981075a}, fpelt{0x6af0374380689dca, 0x4f8fdfce1997e7a7}},
+			fp2elt{fpelt{0xe4b64166d023d3b7, 0x34164be923e05e06}, fpelt{0x8ec18a4546a9dbf5, 0x74bb557e7a3f0c99}},
+		},
+		{
+			fp2elt{fpelt{0x32de63300bfcfbae, 0x5d0c1a3c167ef8a5}, fpelt{0x950fc8bc7f976235, 0x30702031e6681858}},
+			fp2elt{fpelt{0xe4b64166d023d3b7, 0x34164be923e05e06}, fpelt{0x8ec18a4546a9dbf5, 0x74bb557e7a3f0c99}},
+		},
+		{
+			fp2elt{fpelt{0x0477678f9fe5b3a9, 0x3932e662f1eec620}, fpelt{0x49ffae63fa8c7dee, 0x77fe76f6067aa39e}},
+			fp2elt{fpelt{0xff16572a4aa310b8, 0x6a905e0d05f43890}, fpelt{0xb448f89ba4aa3c39, 0x2fd247a52d846535}},
+		},
+		{
+			fp2elt{fpelt{0xfb889870601a4c56, 0x46cd199d0e1139df}, fpelt{0xb600519c05738211, 0x08018909f9855c61}},
+			fp2elt{fpelt{0xff16572a4aa310b8, 0x6a905e0d05f43890}, fpelt{0xb448f89ba4aa3c39, 0x2fd247a52d846535}},
+		},
+		{
+			fp2elt{fpelt{0x6da71a208e480402, 0x32dbd3b046cb12b7}, fpelt{0x180597b5d09f9511, 0x5175279da143afa6}},
+			fp2elt{fpelt{0x07097d00a4d619bb, 0x1ff0346f5c7ace44}, fpelt{0x64083bb6d7fbfc1e, 0x78182d1c84143196}},
+		},
+		{
+			fp2elt{fpelt{0x9258e5df71b7fbfd, 0x4d242c4fb934ed48}, fpelt{0xe7fa684a2f606aee, 0x2e8ad8625ebc5059}},
+			fp2elt{fpelt{0x07097d00a4d619bb, 0x1ff0346f5c7ace44}, fpelt{0x64083bb6d7fbfc1e, 0x78182d1c84143196}},
+		},
+		{
+			fp2elt{fpelt{0x328c91176db9f2e4, 0x14fb45c745df3a0d}, fpelt{0xfba0831e6a493163, 0x09b0b08a9a8fb137}},
+			fp2elt{fpelt{0x54f1ea7a0c03b0bc, 0x30672d858b4bfcc0}, fpelt{0x446ca9d56c31707f, 0x519a1695272147a8}},
+		},
+		{
+			fp2elt{fpelt{0xcd736ee892460d1b, 0x6b04ba38ba20c5f2}, fpelt{0x045f7ce195b6ce9c, 0x764f4f7565704ec8}},
+			fp2elt{fpelt{0x54f1ea7a0c03b0bc, 0x30672d858b4bfcc0}, fpelt{0x446ca9d56c31707f, 0x519a1695272147a8}},
+		},
+		{
+			fp2elt{fpelt{0x94a7ad614005b358, 0x04b823d6d3577a50}, fpelt{0x2cb38417d64493f5, 0x1decf07ffbb875e9}},
+			fp2elt{fpelt{0x7f57187c449519be, 0x7b4b1b583d603879}, fpelt{0x852c8e2369d14771, 0x14d376576651c5d6}},
+		},
+		{
+			fp2elt{fpelt{0x6b58529ebffa4ca7, 0x7b47dc292ca885af}, fpelt{0xd34c7be829bb6c0a, 0x62130f8004478a16}},
+			fp2elt{fpelt{0x7f57187c449519be, 0x7b4b1b583d603879}, fpelt{0x852c8e2369d14771, 0x14d376576651c5d6}},
+		},
+		{
+			fp2elt{fpelt{0xd513d5a2d8bf057c, 0x0819ee5d76062217}, fpelt{0x45755a772bb1ca4b, 0x0d15e3caa9364943}},
+			fp2elt{fpelt{0xf79ac10fadddd2be, 0x5e1391433c6a3f8c}, fpelt{0xc1cc983e158cf82d, 0x35b2c84beadd620f}},
+		},
+		{
+			fp2elt{fpelt{0x2aec2a5d2740fa83, 0x77e611a289f9dde8}, fpelt{0xba8aa588d44e35b4, 0x72ea1c3556c9b6bc}},
+			fp2elt{fpelt{0xf79ac10fadddd2be, 0x5e1391433c6a3f8c}, fpelt{0xc1cc983e158cf82d, 0x35b2c84beadd620f}},
+		},
+		{
+			fp2elt{fpelt{0x1f58edfa1a60b426, 0x0fdd975e27ed6a58}, fpelt{0x3acbb6f9a0d58974, 0x2c339a351f104e03}},
+			fp2elt{fpelt{0x51f7e05e24a63ac0, 0x5d369b475940e11f}, fpelt{0xbd1b6a6a0d24c5e5, 0x4351c9837f661235}},
+		},
+		{
+			fp2elt{fpelt{0xe0a71205e59f4bd9, 0x702268a1d81295a7}, fpelt{0xc53449065f2a768b, 0x53cc65cae0efb1fc}},
+			fp2elt{fpelt{0x51f7e05e24a63ac0, 0x5d369b475940e11f}, fpelt{0xbd1b6a6a0d24c5e5, 0x4351c9837f661235}},
+		},
+		{
+			fp2elt{fpelt{0x21e83c5d071c82fb, 0x27ac9b7f5e8548c2}, fpelt{0x84d7a10fb73aadd3, 0x544dc3747f49b1eb}},
+			fp2elt{fpelt{0x0cdfc468504ccec2, 0x61457c849f1c7c3b}, fpelt{0xdbe3568055803361, 0x468135d482d5d5a9}},
+		},
+		{
+			fp2elt{fpelt{0xde17c3a2f8e37d04, 0x58536480a17ab73d}, fpelt{0x7b285ef048c5522c, 0x2bb23c8b80b64e14}},
+			fp2elt{fpelt{0x0cdfc468504ccec2, 0x61457c849f1c7c3b}, fpelt{0xdbe3568055803361, 0x468135d482d5d5a9}},
+		},
+		{
+			fp2elt{fpelt{0xa9b0969945275b61, 0x2b2e52b01637aa2f}, fpelt{0x5c61e1c33a4cd19e, 0x0d53645353b9bfcf}},
+			fp2elt{fpelt{0xd2b0ec8479083dc4, 0x7b31ae355781b695}, fpelt{0xb6077564a321719c, 0x74b8d2e9832abf90}},
+		},
+		{
+			fp2elt{fpelt{0x564f6966bad8a49e, 0x54d1ad4fe9c855d0}, fpelt{0xa39e1e3cc5b32e61, 0x72ac9bacac464030}},
+			fp2elt{fpelt{0xd2b0ec8479083dc4, 0x7b31ae355781b695}, fpelt{0xb6077564a321719c, 0x74b8d2e9832abf90}},
+		},
+		{
+			fp2elt{fpelt{0x9b7935ede21e7e6d, 0x14b69b2eaf07ba0d}, fpelt{0xdba41d9c8c9c0af5, 0x27570f9a6e26b9f9}},
+			fp2elt{fpelt{0xccc53abfc5329ec4, 0x3eee0ff39677bc6b}, fpelt{0x5ccb78db60ff4f00, 0x1777b96609c94057}},
+		},
+		{
+			fp2elt{fpelt{0x6486ca121de18192, 0x6b4964d150f845f2}, fpelt{0x245be2637363f50a, 0x58a8f06591d94606}},
+			fp2elt{fpelt{0xccc53abfc5329ec4, 0x3eee0ff39677bc6b}, fpelt{0x5ccb78db60ff4f00, 0x1777b96609c94057}},
+		},
+		{
+			fp2elt{fpelt{0xeb4889504633a7b1, 0x235f89ae8c4943f5}, fpelt{0x619fac301db3e861, 0x755755b218a93db2}},
+			fp2elt{fpelt{0x0a579aa0f3cecdc4, 0x5f30db0fed597acc}, fpelt{0x98f1e9e0ce6e41d1, 0x7d02806127b6a3c5}},
+		},
+		{
+			fp2elt{fpelt{0x14b776afb9cc584e, 0x5ca0765173b6bc0a}, fpelt{0x9e6053cfe24c179e, 0x0aa8aa4de756c24d}},
+			fp2elt{fpelt{0x0a579aa0f3cecdc4, 0x5f30db0fed597acc}, fpelt{0x98f1e9e0ce6e41d1, 0x7d02806127b6a3c5}},
+		},
+		{
+			fp2elt{fpelt{0x43230a76b806d45a, 0x1edfd25addbd6211}, fpelt{0xc4a73239c918c9a0, 0x71c9d0764cc727d0}},
+			fp2elt{fpelt{0x1bb8409f2d1e8ac5, 0x30fa2fe0f7d58f16}, fpelt{0xbd3f7bdf73de8539, 0x1b334b27e9d13e13}},
+		},
+		{
+			fp2elt{fpelt{0xbcdcf58947f92ba5, 0x61202da522429dee}, fpelt{0x3b58cdc636e7365f, 0x0e362f89b338d82f}},
+			fp2elt{fpelt{0x1bb8409f2d1e8ac5, 0x30fa2fe0f7d58f16}, fpelt{0xbd3f7bdf73de8539, 0x1b334b27e9d13e13}},
+		},
+		{
+			fp2elt{fpelt{0xa53970e7aa6a3da5, 0x1e753d4ad079a79f}, fpelt{0x60cc0f352e2ebf25, 0x54fbff11f779ef0b}},
+			fp2elt{fpelt{0x0609a4c440fa2fc7, 0x4ff0f340e2a5fada}, fpelt{0x8f0364d06ed260b4, 0x59d19d7f4fc385d6}},
+		},
+		{
+			fp2elt{fpelt{0x5ac68f185595c25a, 0x618ac2b52f865860}, fpelt{0x9f33f0cad1d140da, 0x2b0400ee088610f4}},
+			fp2elt{fpelt{0x0609a4c440fa2fc7, 0x4ff0f340e2a5fada}, fpelt{0x8f0364d06ed260b4, 0x59d19d7f4fc385d6}},
+		},
+		{
+			fp2elt{fpelt{0x713e75bab956240a, 0x0b44aa8185c0f366}, fpelt{0xe4b64166d023d3b7, 0x34164be923e05e06}},
+			fp2elt{fpelt{0x6af0374380689dca, 0x4f8fdfce1997e7a7}, fpelt{0x32de63300bfcfbae, 0x5d0c1a3c167ef8a5}},
+		},
+		{
+			fp2elt{fpelt{0x8ec18a4546a9dbf5, 0x74bb557e7a3f0c99}, fpelt{0x1b49be992fdc2c48, 0x4be9b416dc1fa1f9}},
+			fp2elt{fpelt{0x6af0374380689dca, 0x4f8fdfce1997e7a7}, fpelt{0x32de63300bfcfbae, 0x5d0c1a3c167ef8a5}},
+		},
+		{
+			fp2elt{fpelt{0xe4ab575792e5ab35, 0x134ab45a78240a77}, fpelt{0xf672b649cb576b02, 0x0299cbc61a2b2a7b}},
+			fp2elt{fpelt{0x99078be54004b3ca, 0x40ce683c8948f1e8}, fpelt{0x8652753647c0310c, 0x509798cbbe488a19}},
+		},
+		{
+			fp2elt{fpelt{0x1b54a8a86d1a54ca, 0x6cb54ba587dbf588}, fpelt{0x098d49b634a894fd, 0x7d663439e5d4d584}},
+			fp2elt{fpelt{0x99078be54004b3ca, 0x40ce683c8948f1e8}, fpelt{0x8652753647c0310c, 0x509798cbbe488a19}},
+		},
+		{
+			fp2elt{fpelt{0xe0e0091db2117d76, 0x1b7077af978ae4c8}, fpelt{0x228b9bbe951f90ce, 0x1c69fd11fdea9df2}},
+			fp2elt{fpelt{0x41a2711c17aa1bcb, 0x113a78ef13a246fb}, fpelt{0xe38010145fe33b83, 0x6c459ee0a70bd152}},
+		},
+		{
+			fp2elt{fpelt{0x1f1ff6e24dee8289, 0x648f885068751b37}, fpelt{0xdd7464416ae06f31, 0x639602ee0215620d}},
+			fp2elt{fpelt{0x41a2711c17aa1bcb, 0x113a78ef13a246fb}, fpelt{0xe38010145fe33b83, 0x6c459ee0a70bd152}},
+		},
+		{
+			fp2elt{fpelt{0xb139f2046633d55f, 0x181816466e360f95}, fpelt{0x29627ea32c2f39e1, 0x4aa519bcff91f2c6}},
+			fp2elt{fpelt{0x07bf0daa9440d1cb, 0x7889f8c6e59615fc}, fpelt{0x5f8d4a89e285db91, 0x2fa97e664738a2cd}},
+		},
+		{
+			fp2elt{fpelt{0x4ec60dfb99cc2aa0, 0x67e7e9b991c9f06a}, fpelt{0xd69d815cd3d0c61e, 0x355ae643006e0d39}},
+			fp2elt{fpelt{0x07bf0daa9440d1cb, 0x7889f8c6e59615fc}, fpelt{0x5f8d4a89e285db91, 0x2fa97e664738a2cd}},
+		},
+		{
+			fp2elt{fpelt{0xf47105eedcf0cabf, 0x0e0233906aadf306}, fpelt{0x810c0f0f4aa8a17b, 0x3e8849360050af33}},
+			fp2elt{fpelt{0x65771a59467d35cd, 0x5132a996323036ab}, fpelt{0x56ff5a886c8abe8c, 0x2bcc52b2c4f41401}},
+		},
+		{
+			fp2elt{fpelt{0x0b8efa11230f3540, 0x71fdcc6f95520cf9}, fpelt{0x7ef3f0f0b5575e84, 0x4177b6c9ffaf50cc}},
+			fp2elt{fpelt{0x65771a59467d35cd, 0x5132a996323036ab}, fpelt{0x56ff5a886c8abe8c, 0x2bcc52b2c4f41401}},
+		},
+		{
+			fp2elt{fpelt{0x1c7fefeba01cc47c, 0x13ba611f58f42ead}, fpelt{0x41a2711c17aa1bcb, 0x113a78ef13a246fb}},
+			fp2elt{fpelt{0x228b9bbe951f90ce, 0x1c69fd11fdea9df2}, fpelt{0x1f1ff6e24dee8289, 0x648f885068751b37}},
+		},
+		{
+			fp2elt{fpelt{0xe38010145fe33b83, 0x6c459ee0a70bd152}, fpelt{0xbe5d8ee3e855e434, 0x6ec58710ec5db904}},
+			fp2elt{fpelt{0x228b9bbe951f90ce, 0x1c69fd11fdea9df2}, fpelt{0x1f1ff6e24dee8289, 0x648f885068751b37}},
+		},
+		{
+			fp2elt{fpelt{0xdd57cd1f18046539, 0x1c1ef3ffafcd3359}, fpelt{0xacdb0da489b38481, 0x12e446a2db3fbbc3}},
+			fp2elt{fpelt{0xce7b0fa3320e2fcf, 0x60d72a621894dfa9}, fpelt{0x8acb67f99c80560f, 0x129aa707e3726825}},
+		},
+		{
+			fp2elt{fpelt{0x22a832e0e7fb9ac6, 0x63e10c005032cca6}, fpelt{0x5324f25b764c7b7e, 0x6d1bb95d24c0443c}},
+			fp2elt{fpelt{0xce7b0fa3320e2fcf, 0x60d72a621894dfa9}, fpelt{0x8acb67f99c80560f, 0x129aa707e3726825}},
+		},
+		{
+			fp2elt{fpelt{0x18ab5cc3e899f879, 0x0508f6e5bc738db6}, fpelt{0x415a03c7d4c664aa, 0x3e3f823b64ac6798}},
+			fp2elt{fpelt{0x2b63c210cb8c37cf, 0x59d3c31a731b30b3}, fpelt{0x4d28913e8e01b8f1, 0x72966a5712b6d5e7}},
+		},
+		{
+			fp2elt{fpelt{0xe754a33c17660786, 0x7af7091a438c7249}, fpelt{0xbea5fc382b399b55, 0x41c07dc49b539867}},
+			fp2elt{fpelt{0x2b63c210cb8c37cf, 0x59d3c31a731b30b3}, fpelt{0x4d28913e8e01b8f1, 0x72966a5712b6d5e7}},
+		},
+		{
+			fp2elt{fpelt{0xb9af5d0c6ae5c074, 0x33a346a7083bcddd}, fpelt{0xf759bdd9e536774e, 0x02ad0e0570efb122}},
+			fp2elt{fpelt{0x3fb9d21b3b5157cf, 0x693e1ad89e296dbc}, fpelt{0x8758d5400c11c95d, 0x3f97242266fbceeb}},
+		},
+		{
+			fp2elt{fpelt{0x4650a2f3951a3f8b, 0x4c5cb958f7c43222}, fpelt{0x08a642261ac988b1, 0x7d52f1fa8f104edd}},
+			fp2elt{fpelt{0x3fb9d21b3b5157cf, 0x693e1ad89e296dbc}, fpelt{0x8758d5400c11c95d, 0x3f97242266fbceeb}},
+		},
+		{
+			fp2elt{fpelt{0x3c9b73c751b4b192, 0x1318020702de23bc}, fpelt{0x18e3c409fbd81a95, 0x77ab39a7d8990c0a}},
+			fp2elt{fpelt{0xe15d4bc4aede5dd1, 0x2ea7ab492e633d25}, fpelt{0x28b200af9c19997d, 0x09c0761ed6b68c9e}},
+		},
+		{
+			fp2elt{fpelt{0xc3648c38ae4b4e6d, 0x6ce7fdf8fd21dc43}, fpelt{0xe71c3bf60427e56a, 0x0854c6582766f3f5}},
+			fp2elt{fpelt{0xe15d4bc4aede5dd1, 0x2ea7ab492e633d25}, fpelt{0x28b200af9c19997d, 0x09c0761ed6b68c9e}},
+		},
+		{
+			fp2elt{fpelt{0xa7789d7d70b97d33, 0x0176ab60c10abfa2}, fpelt{0x673c39241d2df5ff, 0x2f984ea7fb61760a}},
+			fp2elt{fpelt{0xc2ea68f63345e8d2, 0x7a4af5ffbc4f79ef}, fpelt{0x73539d779e85b789, 0x39e7e38582985bee}},
+		},
+		{
+			fp2elt{fpelt{0x588762828f4682cc, 0x7e89549f3ef5405d}, fpelt{0x98c3c6dbe2d20a00, 0x5067b158049e89f5}},
+			fp2elt{fpelt{0xc2ea68f63345e8d2, 0x7a4af5ffbc4f79ef}, fpelt{0x73539d779e85b789, 0x39e7e38582985bee}},
+		},
+		{
+			fp2elt{fpelt{0x241ca97faa7fcc9e, 0x397eca2b7d2a2a56}, fpelt{0x0cdfc468504ccec2, 0x61457c849f1c7c3b}},
+			fp2elt{fpelt{0x84d7a10fb73aadd3, 0x544dc3747f49b1eb}, fpelt{0xde17c3a2f8e37d04, 0x58536480a17ab73d}},
+		},
+		{
+			fp2elt{fpelt{0xdbe3568055803361, 0x468135d482d5d5a9}, fpelt{0xf3203b97afb3313d, 0x1eba837b60e383c4}},
+			fp2elt{fpelt{0x84d7a10fb73aadd3, 0x544dc3747f49b1eb}, fpelt{0xde17c3a2f8e37d04, 0x58536480a17ab73d}},
+		},
+		{
+			fp2elt{fpelt{0x87f36665ed8ee756, 0x0cd4c294e19270f1}, fpelt{0x05d0c86247e807d8, 0x2403b34bd61f25a5}},
+			fp2elt{fpelt{0x5c9acfcc86fcecd5, 0x24e9764720192432}, fpelt{0xeef4a9e67c11e433, 0x24c212265db1c576}},
+		},
+		{
+			fp2elt{fpelt{0x780c999a127118a9, 0x732b3d6b1e6d8f0e}, fpelt{0xfa2f379db817f827, 0x5bfc4cb429e0da5a}},
+			fp2elt{fpelt{0x5c9acfcc86fcecd5, 0x24e9764720192432}, fpelt{0xeef4a9e67c11e433, 0x24c212265db1c576}},
+		},
+		{
+			fp2elt{fpelt{0x25d758839145c409, 0x09d60809d6f9a182}, fpelt{0x896e9e305798d770, 0x62f20983304cacd2}},
+			fp2elt{fpelt{0x7f0561a342b2efd5, 0x2cdb29a15c8ff663}, fpelt{0x66a35c1cc8392db9, 0x66f0269a6db21639}},
+		},
+		{
+			fp2elt{fpelt{0xda28a77c6eba3bf6, 0x7629f7f629065e7d}, fpelt{0x769161cfa867288f, 0x1d0df67ccfb3532d}},
+			fp2elt{fpelt{0x7f0561a342b2efd5, 0x2cdb29a15c8ff663}, fpelt{0x66a35c1cc8392db9, 0x66f0269a6db21639}},
+		},
+		{
+			fp2elt{fpelt{0xc57ced3f786c3baa, 0x09f320ea47b32b22}, fpelt{0xa3d29eaf6e48830d, 0x0a48b93dde2c79c9}},
+			fp2elt{fpelt{0x4634ed443181b9d6, 0x2808b5a9ce7f669b}, fpelt{0xac5c2edceae36a80, 0x14a55eab61730ce3}},
+		},
+		{
+			fp2elt{fpelt{0x3a8312c08793c455, 0x760cdf15b84cd4dd}, fpelt{0x5c2d615091b77cf2, 0x75b746c221d38636}},
+			fp2elt{fpelt{0x4634ed443181b9d6, 0x2808b5a9ce7f669b}, fpelt{0xac5c2edceae36a80, 0x14a55eab61730ce3}},
+		},
+		{
+			fp2elt{fpelt{0xe3b209f6d0528e20, 0x105ba2865134c01d}, fpelt{0x04836dcb0bc81859, 0x1aad3f1e82592105}},
+			fp2elt{fpelt{0x32ff6e9a502d3ed7, 0x0af6305e293e3fbc}, fpelt{0xf91cf409d5cdbece, 0x315e6ca16bd2e816}},
+		},
+		{
+			fp2elt{fpelt{0x1c4df6092fad71df, 0x6fa45d79aecb3fe2}, fpelt{0xfb7c9234f437e7a6, 0x6552c0e17da6defa}},
+			fp2elt{fpelt{0x32ff6e9a502d3ed7, 0x0af6305e293e3fbc}, fpelt{0xf91cf409d5cdbece, 0x315e6ca16bd2e816}},
+		},
+		{
+			fp2elt{fpelt{0xeef4a9e67c11e433, 0x24c212265db1c576}, fpelt{0xa36530337903132a, 0x5b1689b8dfe6dbcd}},
+			fp2elt{fpelt{0x05d0c86247e807d8, 0x2403b34bd61f25a5}, fpelt{0x780c999a127118a9, 0x732b3d6b1e6d8f0e}},
+		},
+		{
+			fp2elt{fpelt{0x110b561983ee1bcc, 0x5b3dedd9a24e3a89}, fpelt{0x5c9acfcc86fcecd5, 0x24e9764720192432}},
+			fp2elt{fpelt{0x05d0c86247e807d8, 0x2403b34bd61f25a5}, fpelt{0x780c999a127118a9, 0x732b3d6b1e6d8f0e}},
+		},
+		{
+			fp2elt{fpelt{0xa22b23f8af95c6a9, 0x14e744a7324700cf}, fpelt{0x5a75d7c8333bc6fe, 0x08e4cbe44b241195}},
+			fp2elt{fpelt{0xd40cc8a5acc06ed8, 0x67083c9699796c7a}, fpelt{0xb4231f9f4cf4cce5, 0x2e1ac5842be9bf48}},
+		},
+		{
+			fp2elt{fpelt{0x5dd4dc07506a3956, 0x6b18bb58cdb8ff30}, fpelt{0xa58a2837ccc43901, 0x771b341bb4dbee6a}},
+			fp2elt{fpelt{0xd40cc8a5acc06ed8, 0x67083c9699796c7a}, fpelt{0xb4231f9f4cf4cce5, 0x2e1ac5842be9bf48}},
+		},
+		{
+			fp2elt{fpelt{0xf37111e81638aaa0, 0x246487cb2acd7478}, fpelt{0x140097093cdfbab2, 0x58ff3488f3aca3e4}},
+			fp2elt{fpelt{0xe1e91dd83e15b7d8, 0x53538056fc72a872}, fpelt{0xacf85a0202896777, 0x1ea80bbbce95efa9}},
+		},
+		{
+			fp2elt{fpelt{0x0c8eee17e9c7555f, 0x5b9b7834d5328b87}, fpelt{0xebff68f6c320454d, 0x2700cb770c535c1b}},
+			fp2elt{fpelt{0xe1e91dd83e15b7d8, 0x53538056fc72a872}, fpelt{0xacf85a0202896777, 0x1ea80bbbce95efa9}},
+		},
+		{
+			fp2elt{fpelt{0x70fc9b2f912d9f4b, 0x262e6280b03c7a29}, fpelt{0x0609a4c440fa2fc7, 0x4ff0f340e2a5fada}},
+			fp2elt{fpelt{0x9f33f0cad1d140da, 0x2b0400ee088610f4}, fpelt{0xa53970e7aa6a3da5, 0x1e753d4ad079a79f}},
+		},
+		{
+			fp2elt{fpelt{0x8f0364d06ed260b4, 0x59d19d7f4fc385d6}, fpelt{0xf9f65b3bbf05d038, 0x300f0cbf1d5a0525}},
+			fp2elt{fpelt{0x9f33f0cad1d140da, 0x2b0400ee088610f4}, fpelt{0xa53970e7aa6a3da5, 0x1e753d4ad079a79f}},
+		},
+		{
+			fp2elt{fpelt{0xb8f97c7a6805ba86, 0x2dd1d1d7563d1c8a}, fpelt{0xcdbb81a753ca194d, 0x38d2fb686b216ec8}},
+			fp2elt{fpelt{0x042791c2b1824cdb, 0x632158b706efd413}, fpelt{0x89b0e03fd0825923, 0x24d9ee65ec6b323a}},
+		},
+		{
+			fp2elt{fpelt{0x4706838597fa4579, 0x522e2e28a9c2e375}, fpelt{0x32447e58ac35e6b2, 0x472d049794de9137}},
+			fp2elt{fpelt{0x042791c2b1824cdb, 0x632158b706efd413}, fpelt{0x89b0e03fd0825923, 0x24d9ee65ec6b323a}},
+		},
+		{
+			fp2elt{fpelt{0x0a4e2b3992ef3bd9, 0x3a7a98c0ead3a1a0}, fpelt{0xeed30eb6221967f5, 0x208405db59470d56}},
+			fp2elt{fpelt{0x1cbfd5cc38dde1db, 0x75e637b3f5005cee}, fpelt{0x2b60907f8fa20845, 0x1fdb593811887b26}},
+		},
+		{
+			fp2elt{fpelt{0xf5b1d4c66d10c426, 0x4585673f152c5e5f}, fpelt{0x112cf149dde6980a, 0x5f7bfa24a6b8f2a9}},
+			fp2elt{fpelt{0x1cbfd5cc38dde1db, 0x75e637b3f5005cee}, fpelt{0x2b60907f8fa20845, 0x1fdb593811887b26}},
+		},
+		{
+			fp2elt{fpelt{0xe09c24b8e5f51a0e, 0x2c8e320769c4c3ed}, fpelt{0x10bededdc41b8e50, 0x271afea8fd9845ed}},
+			fp2elt{fpelt{0xd752702565b2dade, 0x292d15ecfd02c25d}, fpelt{0xd893e1aa0421e5c0, 0x748646d65442509b}},
+		},
+		{
+			fp2elt{fpelt{0x1f63db471a0ae5f1, 0x5371cdf8963b3c12}, fpelt{0xef4121223be471af, 0x58e501570267ba12}},
+			fp2elt{fpelt{0xd752702565b2dade, 0x292d15ecfd02c25d}, fpelt{0xd893e1aa0421e5c0, 0x748646d65442509b}},
+		},
+		{
+			fp2elt{fpelt{0x5f8d4a89e285db91, 0x2fa97e664738a2cd}, fpelt{0xf840f2556bbf2e34, 0x077607391a69ea03}},
+			fp2elt{fpelt{0x29627ea32c2f39e1, 0x4aa519bcff91f2c6}, fpelt{0x4ec60dfb99cc2aa0, 0x67e7e9b991c9f06a}},
+		},
+		{
+			fp2elt{fpelt{0xa072b5761d7a246e, 0x50568199b8c75d32}, fpelt{0x07bf0daa9440d1cb, 0x7889f8c6e59615fc}},
+			fp2elt{fpelt{0x29627ea32c2f39e1, 0x4aa519bcff91f2c6}, fpelt{0x4ec60dfb99cc2aa0, 0x67e7e9b991c9f06a}},
+		},
+		{
+			fp2elt{fpelt{0x05ff47d19e712c2f, 0x02e3b4ecaa3c8abd}, fpelt{0xa6e53bbfd02fe55a, 0x058aa16ee6b83c86}},
+			fp2elt{fpelt{0xd98e799bc22709e3, 0x101838d0e89eeeb8}, fpelt{0x8855848f6c641ffc, 0x3bd16e4b3e9df7e1}},
+		},
+		{
+			fp2elt{fpelt{0xfa00b82e618ed3d0, 0x7d1c4b1355c37542}, fpelt{0x591ac4402fd01aa5, 0x7a755e911947c379}},
+			fp2elt{fpelt{0xd98e799bc22709e3, 0x101838d0e89eeeb8}, fpelt{0x8855848f6c641ffc, 0x3bd16e4b3e9df7e1}},
+		},
+		{
+			fp2elt{fpelt{0xba9dfadcc2772ab6, 0x340be924a3807210}, fpelt{0x337c3e5f11240ba8, 0x4e5ebe04daa29ac6}},
+			fp2elt{fpelt{0x7067f77e981fafe7, 0x2e58777b7777a081}, fpelt{0xc75ced39803dc452, 0x66192727aaaac92a}},
+		},
+		{
+			fp2elt{fpelt{0x456205233d88d549, 0x4bf416db5c7f8def}, fpelt{0xcc83c1a0eedbf457, 0x31a141fb255d6539}},
+			fp2elt{fpelt{0x7067f77e981fafe7, 0x2e58777b7777a081}, fpelt{0xc75ced39803dc452, 0x66192727aaaac92a}},
+		},
+		{
+			fp2elt{fpelt{0x1c90b08806ec9351, 0x241d6b6652047b41}, fpelt{0x7487934b45a90e01, 0x13ebfea2f0593b9d}},
+			fp2elt{fpelt{0x9ff6f5c1f0f9c4e9, 0x63e977c83c3d6afc}, fpelt{0xbd426ef774d6889c, 0x5fdda301967d438e}},
+		},
+		{
+			fp2elt{fpelt{0xe36f4f77f9136cae, 0x5be29499adfb84be}, fpelt{0x8b786cb4ba56f1fe, 0x6c14015d0fa6c462}},
+			fp2elt{fpelt{0x9ff6f5c1f0f9c4e9, 0x63e977c83c3d6afc}, fpelt{0xbd426ef774d6889c, 0x5fdda301967d438e}},
+		},
+		{
+			fp2elt{fpelt{0x98f0e939d9bcc9d6, 0x04330a5f988b97f3}, fpelt{0x5d26edda931026ed, 0x38c65bdecc4443f3}},
+			fp2elt{fpelt{0x4dd4b64cfad340ea, 0x666df92f27c8aae7}, fpelt{0x47f2d412fb4a4cba, 0x114a56514a54b905}},
+		},
+		{
+			fp2elt{fpelt{0x670f16c626433629, 0x7bccf5a06774680c}, fpelt{0xa2d912256cefd912, 0x4739a42133bbbc0c}},
+			fp2elt{fpelt{0x4dd4b64cfad340ea, 0x666df92f27c8aae7}, fpelt{0x47f2d412fb4a4cba, 0x114a56514a54b905}},
+		},
+		{
+			fp2elt{fpelt{0x8ffc1b7079c06760, 0x17cbe1b8f02fb404}, fpelt{0xe255773d91663c14, 0x43506315604d2fd3}},
+			fp2elt{fpelt{0x1daa88c26e99c3eb, 0x3caf9cea9fb2d02c}, fpelt{0x8ffc1b7079c06760, 0x17cbe1b8f02fb404}},
+		},
+		{
+			fp2elt{fpelt{0x7003e48f863f989f, 0x68341e470fd04bfb}, fpelt{0x1daa88c26e99c3eb, 0x3caf9cea9fb2d02c}},
+			fp2elt{fpelt{0x1daa88c26e99c3eb, 0x3caf9cea9fb2d02c}, fpelt{0x8ffc1b7079c06760, 0x17cbe1b8f02fb404}},
+		},
+		{
+			fp2elt{fpelt{0xc83b0bb3fca2ef41, 0x0e0ac36ba2066a1a}, fpelt{0xe66158bde7063f5f, 0x5c4497950826ca6b}},
+			fp2elt{fpelt{0xf7134500e0140fed, 0x38ecf9009337cf40}, fpelt{0x11c4f540803d804e, 0x30508c85e16e57d5}},
+		},
+		{
+			fp2elt{fpelt{0x37c4f44c035d10be, 0x71f53c945df995e5}, fpelt{0x199ea74218f9c0a0, 0x23bb686af7d93594}},
+			fp2elt{fpelt{0xf7134500e0140fed, 0x38ecf9009337cf40}, fpelt{0x11c4f540803d804e, 0x30508c85e16e57d5}},
+		},
+		{
+			fp2elt{fpelt{0x47f2d412fb4a4cba, 0x114a56514a54b905}, fpelt{0xb22b49b3052cbf15, 0x199206d0d8375518}},
+			fp2elt{fpelt{0x5d26edda931026ed, 0x38c65bdecc4443f3}, fpelt{0x670f16c626433629, 0x7bccf5a06774680c}},
+		},
+		{
+			fp2elt{fpelt{0xb80d2bed04b5b345, 0x6eb5a9aeb5ab46fa}, fpelt{0x4dd4b64cfad340ea, 0x666df92f27c8aae7}},
+			fp2elt{fpelt{0x5d26edda931026ed, 0x38c65bdecc4443f3}, fpelt{0x670f16c626433629, 0x7bccf5a06774680c}},
+		},
+		{
+			fp2elt{fpelt{0x9bf7c449280403e1, 0x07e7d2e37bebce69}, fpelt{0x07097d00a4d619bb, 0x1ff0346f5c7ace44}},
+			fp2elt{fpelt{0xe7fa684a2f606aee, 0x2e8ad8625ebc5059}, fpelt{0x6da71a208e480402, 0x32dbd3b046cb12b7}},
+		},
+		{
+			fp2elt{fpelt{0x64083bb6d7fbfc1e, 0x78182d1c84143196}, fpelt{0xf8f682ff5b29e644, 0x600fcb90a38531bb}},
+			fp2elt{fpelt{0xe7fa684a2f606aee, 0x2e8ad8625ebc5059}, fpelt{0x6da71a208e480402, 0x32dbd3b046cb12b7}},
+		},
+		{
+			fp2elt{fpelt{0xb448f89ba4aa3c39, 0x2fd247a52d846535}, fpelt{0x00e9a8d5b55cef47, 0x156fa1f2fa0bc76f}},
+			fp2elt{fpelt{0x49ffae63fa8c7dee, 0x77fe76f6067aa39e}, fpelt{0xfb889870601a4c56, 0x46cd199d0e1139df}},
+		},
+		{
+			fp2elt{fpelt{0x4bb707645b55c3c6, 0x502db85ad27b9aca}, fpelt{0xff16572a4aa310b8, 0x6a905e0d05f43890}},
+			fp2elt{fpelt{0x49ffae63fa8c7dee, 0x77fe76f6067aa39e}, fpelt{0xfb889870601a4c56, 0x46cd199d0e1139df}},
+		},
+		{
+			fp2elt{fpelt{0xac5c2edceae36a80, 0x14a55eab61730ce3}, fpelt{0xb9cb12bbce7e4629, 0x57f74a5631809964}},
+			fp2elt{fpelt{0x5c2d615091b77cf2, 0x75b746c221d38636}, fpelt{0xc57ced3f786c3baa, 0x09f320ea47b32b22}},
+		},
+		{
+			fp2elt{fpelt{0x53a3d123151c957f, 0x6b5aa1549e8cf31c}, fpelt{0x4634ed443181b9d6, 0x2808b5a9ce7f669b}},
+			fp2elt{fpelt{0x5c2d615091b77cf2, 0x75b746c221d38636}, fpelt{0xc57ced3f786c3baa, 0x09f320ea47b32b22}},
+		},
+		{
+			fp2elt{fpelt{0x5ccb78db60ff4f00, 0x1777b96609c94057}, fpelt{0x333ac5403acd613b, 0x4111f00c69884394}},
+			fp2elt{fpelt{0xdba41d9c8c9c0af5, 0x27570f9a6e26b9f9}, fpelt{0x6486ca121de18192, 0x6b4964d150f845f2}},
+		},
+		{
+			fp2elt{fpelt{0xa33487249f00b0ff, 0x68884699f636bfa8}, fpelt{0xccc53abfc5329ec4, 0x3eee0ff39677bc6b}},
+			fp2elt{fpelt{0xdba41d9c8c9c0af5, 0x27570f9a6e26b9f9}, fpelt{0x6486ca121de18192, 0x6b4964d150f845f2}},
+		},
+		{
+			fp2elt{fpelt{0x2b60907f8fa20845, 0x1fdb593811887b26}, fpelt{0xe3402a33c7221e24, 0x0a19c84c0affa311}},
+			fp2elt{fpelt{0xeed30eb6221967f5, 0x208405db59470d56}, fpelt{0xf5b1d4c66d10c426, 0x4585673f152c5e5f}},
+		},
+		{
+			fp2elt{fpelt{0xd49f6f80705df7ba, 0x6024a6c7ee7784d9}, fpelt{0x1cbfd5cc38dde1db, 0x75e637b3f5005cee}},
+			fp2elt{fpelt{0xeed30eb6221967f5, 0x208405db59470d56}, fpelt{0xf5b1d4c66d10c426, 0x4585673f152c5e5f}},
+		},
+		{
+			fp2elt{fpelt{0x852c8e2369d14771, 0x14d376576651c5d6}, fpelt{0x80a8e783bb6ae641, 0x04b4e4a7c29fc786}},
+			fp2elt{fpelt{0x2cb38417d64493f5, 0x1decf07ffbb875e9}, fpelt{0x6b58529ebffa4ca7, 0x7b47dc292ca885af}},
+		},
+		{
+			fp2elt{fpelt{0x7ad371dc962eb88e, 0x6b2c89a899ae3a29}, fpelt{0x7f57187c449519be, 0x7b4b1b583d603879}},
+			fp2elt{fpelt{0x2cb38417d64493f5, 0x1decf07ffbb875e9}, fpelt{0x6b58529ebffa4ca7, 0x7b47dc292ca885af}},
+		},
+		{
+			fp2elt{fpelt{0xabcc4297dd94f468, 0x0e5b75da50d1797a}, fpelt{0x9f375a75fce46fb6, 0x2c7972da1d1fcf39}},
+			fp2elt{fpelt{0x8123ff22707ba6f5, 0x7755ac53e4d3fba9}, fpelt{0x1c191fb4f861ef40, 0x7a114eeb532bb9e4}},
+		},
+		{
+			fp2elt{fpelt{0x5433bd68226b0b97, 0x71a48a25af2e8685}, fpelt{0x60c8a58a031b9049, 0x53868d25e2e030c6}},
+			fp2elt{fpelt{0x8123ff22707ba6f5, 0x7755ac53e4d3fba9}, fpelt{0x1c191fb4f861ef40, 0x7a114eeb532bb9e4}},
+		},
+		{
+			fp2elt{fpelt{0x68a528d86b78e627, 0x374c3f6b6c3ee4d0}, fpelt{0x1ba566106a8ff977, 0x7ea2edd061ec3b55}},
+			fp2elt{fpelt{0xa2ba2b9c59d770f8, 0x376985fdc464b3d4}, fpelt{0xc2846cfaf03bc964, 0x6a6249c3b61bbea4}},
+		},
+		{
+			fp2elt{fpelt{0x975ad727948719d8, 0x48b3c09493c11b2f}, fpelt{0xe45a99ef95700688, 0x015d122f9e13c4aa}},
+			fp2elt{fpelt{0xa2ba2b9c59d770f8, 0x376985fdc464b3d4}, fpelt{0xc2846cfaf03bc964, 0x6a6249c3b61bbea4}},
+		},
+		{
+			fp2elt{fpelt{0xd54baa2ffb5ebf90, 0x0f2e6cbac6991d1d}, fpelt{0x2e17d9306533895b, 0x105db478b5999e91}},
+			fp2elt{fpelt{0x00aaf2d88a8755fb, 0x6538deaa71169889}, fpelt{0x493df66abb1df158, 0x5cb38bbd8d97c1d6}},
+		},
+		{
+			fp2elt{fpelt{0x2ab455d004a1406f, 0x70d193453966e2e2}, fpelt{0xd1e826cf9acc76a4, 0x6fa24b874a66616e}},
+			fp2elt{fpelt{0x00aaf2d88a8755fb, 0x6538deaa71169889}, fpelt{0x493df66abb1df158, 0x5cb38bbd8d97c1d6}},
+		},
+		{
+			fp2elt{fpelt{0x6c9d5405f6b01e72, 0x19b4cee07aa345ea}, fpelt{0x2f88ac35b9941f5a, 0x7d678502d1998b7b}},
+			fp2elt{fpelt{0x1abf148e6755a9fb, 0x2f96b0c86354baae}, fpelt{0x379288d046f64cce, 0x2219bcb951a89211}},
+		},
+		{
+			fp2elt{fpelt{0x9362abfa094fe18d, 0x664b311f855cba15}, fpelt{0xd07753ca466be0a5, 0x02987afd2e667484}},
+			fp2elt{fpelt{0x1abf148e6755a9fb, 0x2f96b0c86354baae}, fpelt{0x379288d046f64cce, 0x2219bcb951a89211}},
+		},
+		{
+			fp2elt{fpelt{0x79ad8ac9b83fcef3, 0x2f68673441b775e6}, fpelt{0x99078be54004b3ca, 0x40ce683c8948f1e8}},
+			fp2elt{fpelt{0x098d49b634a894fd, 0x7d663439e5d4d584}, fpelt{0xe4ab575792e5ab35, 0x134ab45a78240a77}},
+		},
+		{
+			fp2elt{fpelt{0x8652753647c0310c, 0x509798cbbe488a19}, fpelt{0x66f8741abffb4c35, 0x3f3197c376b70e17}},
+			fp2elt{fpelt{0x098d49b634a894fd, 0x7d663439e5d4d584}, fpelt{0xe4ab575792e5ab35, 0x134ab45a78240a77}},
+		},
+		{
+			fp2elt{fpelt{0xb4231f9f4cf4cce5, 0x2e1ac5842be9bf48}, fpelt{0x2bf3375a533f9127, 0x18f7c36966869385}},
+			fp2elt{fpelt{0x5a75d7c8333bc6fe, 0x08e4cbe44b241195}, fpelt{0x5dd4dc07506a3956, 0x6b18bb58cdb8ff30}},
+		},
+		{
+			fp2elt{fpelt{0x4bdce060b30b331a, 0x51e53a7bd41640b7}, fpelt{0xd40cc8a5acc06ed8, 0x67083c9699796c7a}},
+			fp2elt{fpelt{0x5a75d7c8333bc6fe, 0x08e4cbe44b241195}, fpelt{0x5dd4dc07506a3956, 0x6b18bb58cdb8ff30}},
+		},
+		{
+			fp2elt{fpelt{0x42bd91088b297763, 0x20225cfe6982bc71}, fpelt{0x9ff6f5c1f0f9c4e9, 0x63e977c83c3d6afc}},
+			fp2elt{fpelt{0x8b786cb4ba56f1fe, 0x6c14015d0fa6c462}, fpelt{0x1c90b08806ec9351, 0x241d6b6652047b41}},
+		},
+		{
+			fp2elt{fpelt{0xbd426ef774d6889c, 0x5fdda301967d438e}, fpelt{0x60090a3e0f063b16, 0x1c168837c3c29503}},
+			fp2elt{fpelt{0x8b786cb4ba56f1fe, 0x6c14015d0fa6c462}, fpelt{0x1c90b08806ec9351, 0x241d6b6652047b41}},
+		},
+		{
+			fp2elt{fpelt{0x0000000000000000, 0x0000000000000000}, fpelt{0x0000000000000000, 0x0000000000000000}},
+			fp2elt{fpelt{0xfffffffffffffffe, 0x7fffffffffffffff}, fpelt{0x0000000000000000, 0x0000000000000000}},
+		},
+		{
+			fp2elt{fpelt{0x73539d779e85b789, 0x39e7e38582985bee}, fpelt{0x3d159709ccba172d, 0x05b50a0043b08610}},
+			fp2elt{fpelt{0x673c39241d2df5ff, 0x2f984ea7fb61760a}, fpelt{0x588762828f4682cc, 0x7e89549f3ef5405d}},
+		},
+		{
+			fp2elt{fpelt{0x8cac6288617a4876, 0x46181c7a7d67a411}, fpelt{0xc2ea68f63345e8d2, 0x7a4af5ffbc4f79ef}},
+			fp2elt{fpelt{0x673c39241d2df5ff, 0x2f984ea7fb61760a}, fpelt{0x588762828f4682cc, 0x7e89549f3ef5405d}},
+		},
+	}
+
+	montA      = fp2elt{fpelt{0x0000000000000509, 0x7ffffffffffffc70}, fpelt{0xaadcb5733c136818, 0x637a835bf687a14f}}
+	montB      = fp2elt{fpelt{0xfffffffffffffaf4, 0x000000000000038f}, fpelt{0x55234a8cc3ec97e7, 0x1c857ca409785eb0}}
+	montBinv   = fp2elt{fpelt{0xffffffffffffffaf, 0x1fffffffffffffc6}, fpelt{0xd31f7addc380fcdc, 0x486e341ee66a07c0}}
+	montAdiv3  = fp2elt{fpelt{0x00000000000001ad, 0x7ffffffffffffed0}, fpelt{0x38f43c7bbeb122b2, 0x767e2bc9522d35c5}}
+	weierA4    = fp2elt{fpelt{0x7fffffffffffde90, 0x27ffffffffffe85b}, fpelt{0xccac2ba6ff6e287a, 0x3145ed4145e41fc8}}
+	weierA6    = fp2elt{fpelt{0x5ffffffffff64d6d, 0x33fffffffff9248e}, fpelt{0x7017420b4009e05c, 0x0a194be4c985d6ad}}
+	ladderA24  = fp2elt{fpelt{0x0000000000000141, 0x7fffffffffffff1c}, fpelt{0xeab72d5ccf04da06, 0x18dea0d6fda1e853}}
+	basePointU = fp2elt{fpelt{0x3d9f1e91967862ad, 0x2e9d5000221a9877}, fpelt{0xab674752659766d0, 0x5b099af9bffe904c}}
+)
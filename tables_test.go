@@ -0,0 +1,282 @@
+package curve4q
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+	"testing"
+)
+
+// The base point tables in tables.go are generated by this file.  To
+// regenerate them, run "go generate" (or "go test -run=TestTables -update").
+// Larger comb tables can be emitted by also passing -comb-w and -comb-v.
+var (
+	updateTables = flag.Bool("update", false, "regenerate tables.go and the KAT vectors")
+	combWFlag    = flag.Int("comb-w", combW, "comb table teeth (0 to omit)")
+	combVFlag    = flag.Int("comb-v", combV, "number of comb tables")
+)
+
+const (
+	tablesFile = "tables.go"
+
+	// Bit length of the scalars handled by the comb tables
+	combBits = 250
+)
+
+// Fixed-base comb table in the mLSB-set layout used by FourQlib.  For
+// w teeth and v tables, let e = ceil(combBits / (w*v)) and d = e*v.
+// Then for 0 <= j < v and u = (u_0, ..., u_{w-2}) in binary,
+//
+//	T[j*2^(w-1) + u] = [2^(e*j)] (P + sum_{i=1}^{w-1} u_{i-1} [2^(i*d)] P)
+func tableComb(P r1, w, v int) (T []r2) {
+	e := (combBits + w*v - 1) / (w * v)
+	d := e * v
+	n := 1 << uint(w-1)
+
+	// Q[i] = [2^(i*d)] P
+	Q := make([]r1, w)
+	Q[0] = P
+	for i := 1; i < w; i += 1 {
+		Q[i] = Q[i-1]
+		for k := 0; k < d; k += 1 {
+			Q[i] = dbl(Q[i])
+		}
+	}
+
+	T = make([]r2, v*n)
+	for j := 0; j < v; j += 1 {
+		T[j*n] = _R1toR2(Q[0])
+		for u := 1; u < n; u += 1 {
+			// Add the highest tooth in u to the entry without it
+			i := 0
+			for (u >> uint(i+1)) != 0 {
+				i += 1
+			}
+			prev := u ^ (1 << uint(i))
+			T[j*n+u] = _R1toR2(add(_R2toR1(T[j*n+prev]), _R1toR2(Q[i+1])))
+		}
+
+		for i := range Q {
+			for k := 0; k < e; k += 1 {
+				Q[i] = dbl(Q[i])
+			}
+		}
+	}
+	return
+}
+
+func writeFp2(w io.Writer, x fp2elt) {
+	fmt.Fprintf(w, "fp2elt{fpelt{0x%016x, 0x%016x}, fpelt{0x%016x, 0x%016x}}",
+		x[0][0], x[0][1], x[1][0], x[1][1])
+}
+
+func writeR1(w io.Writer, name string, P r1) {
+	fmt.Fprintf(w, "\t%s = r1{\n", name)
+	for _, c := range []fp2elt{P.X, P.Y, P.Z, P.Ta, P.Tb} {
+		fmt.Fprintf(w, "\t\t")
+		writeFp2(w, c)
+		fmt.Fprintf(w, ",\n")
+	}
+	fmt.Fprintf(w, "\t}\n\n")
+}
+
+func writeR2Table(w io.Writer, name string, T []r2) {
+	if T == nil {
+		fmt.Fprintf(w, "\t%s []r2 = nil\n\n", name)
+		return
+	}
+
+	fmt.Fprintf(w, "\t%s = []r2{\n", name)
+	for _, P := range T {
+		fmt.Fprintf(w, "\t\t{\n")
+		for _, c := range []fp2elt{P.N, P.D, P.E, P.F} {
+			fmt.Fprintf(w, "\t\t\t")
+			writeFp2(w, c)
+			fmt.Fprintf(w, ",\n")
+		}
+		fmt.Fprintf(w, "\t\t},\n")
+	}
+	fmt.Fprintf(w, "\t}\n\n")
+}
+
+func writeAffineList(w io.Writer, name string, points []affine) {
+	fmt.Fprintf(w, "\t%s = []affine{\n", name)
+	for _, P := range points {
+		fmt.Fprintf(w, "\t\t{\n")
+		for _, c := range []fp2elt{P.X, P.Y} {
+			fmt.Fprintf(w, "\t\t\t")
+			writeFp2(w, c)
+			fmt.Fprintf(w, ",\n")
+		}
+		fmt.Fprintf(w, "\t\t},\n")
+	}
+	fmt.Fprintf(w, "\t}\n\n")
+}
+
+// Enumerates the 392-torsion, by adding the torsion components of
+// points with small y-coordinates until the group is complete
+func generateSmallOrderPoints() []affine {
+	set := map[affine]bool{{Ox, Oy}: true}
+	for y := uint64(2); len(set) < cofactor; y += 1 {
+		P, err := decode(encode(affine{Y: fp2elt{fpint(y), fpOne}}))
+		if err != nil {
+			continue
+		}
+
+		T := _R1toR2(torsionComponent(_AffineToR1(P)))
+		for added := true; added; {
+			added = false
+			for Q := range set {
+				R := _R1toAffine(add(_AffineToR1(Q), T))
+				if !set[R] {
+					set[R] = true
+					added = true
+				}
+			}
+		}
+	}
+
+	points := make([]affine, 0, len(set))
+	for P := range set {
+		points = append(points, P)
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return bytes.Compare(encode(points[i]), encode(points[j])) < 0
+	})
+	return points
+}
+
+func writeFp2Var(w io.Writer, name string, x fp2elt) {
+	fmt.Fprintf(w, "\t%s = ", name)
+	writeFp2(w, x)
+	fmt.Fprintf(w, "\n")
+}
+
+// The constants of the Montgomery and Weierstrass models and of the
+// ladder, from the formulas in models.go and ladder.go
+func modelConstants() []struct {
+	name  string
+	value fp2elt
+} {
+	three := fp2elt{fpint(3), fpZero}
+	nine := fp2elt{fpint(9), fpZero}
+	twentySeven := fp2elt{fpint(27), fpZero}
+	four := fp2add(fp2Two, fp2Two)
+
+	// a - d, with a = -1
+	amd := fp2sub(fp2neg(fp2One), d)
+	A := fp2mul(fp2mul(fp2Two, fp2sub(d, fp2One)), fp2inv(amd))
+	B := fp2mul(four, fp2inv(amd))
+	A3 := fp2mul(A, fp2sqr(A))
+	B3 := fp2mul(B, fp2sqr(B))
+
+	return []struct {
+		name  string
+		value fp2elt
+	}{
+		{"montA", A},
+		{"montB", B},
+		{"montBinv", fp2inv(B)},
+		{"montAdiv3", fp2mul(A, fp2inv(three))},
+		{"weierA4", fp2mul(fp2sub(three, fp2sqr(A)), fp2inv(fp2mul(three, fp2sqr(B))))},
+		{"weierA6", fp2mul(fp2sub(fp2mul(fp2Two, A3), fp2mul(nine, A)), fp2inv(fp2mul(twentySeven, B3)))},
+		{"ladderA24", fp2mul(fp2sub(A, fp2Two), fp2inv(four))},
+		{"basePointU", fp2mul(fp2add(fp2One, Gy), fp2inv(fp2sub(fp2One, Gy)))},
+	}
+}
+
+func generateTables(cw, cv int) ([]byte, error) {
+	P392 := mulWindowed(scalar{392, 0, 0, 0}, _AffineToR1(affine{Gx, Gy}), nil)
+
+	var comb []r2
+	if cw > 0 {
+		comb = tableComb(P392, cw, cv)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by \"go test -run=TestTables -update\"; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package curve4q\n\n")
+	fmt.Fprintf(&buf, "// Parameters of basePointTableComb, which is only generated if combW > 0;\n// see tableComb\n")
+	fmt.Fprintf(&buf, "const (\n\tcombW = %d\n\tcombV = %d\n)\n\n", cw, cv)
+	fmt.Fprintf(&buf, "var (\n")
+	writeR1(&buf, "basePoint392", P392)
+	writeR2Table(&buf, "basePointTableWin", tableWindowed(P392, windowWidth(true)))
+	writeR2Table(&buf, "basePointTableEndo", tableEndo(P392))
+	writeR2Table(&buf, "basePointTableVarTime", tableEndoVarTime(P392, wnafWidthBase))
+	if comb != nil {
+		writeR2Table(&buf, "basePointTableComb", comb)
+	}
+	writeAffineList(&buf, "smallOrderPoints", generateSmallOrderPoints())
+	for _, c := range modelConstants() {
+		writeFp2Var(&buf, c.name, c.value)
+	}
+	fmt.Fprintf(&buf, ")\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func TestTables(t *testing.T) {
+	cw, cv := *combWFlag, *combVFlag
+	if !*updateTables {
+		cw, cv = combW, combV
+	}
+
+	src, err := generateTables(cw, cv)
+	if err != nil {
+		t.Fatalf("failed to format generated tables: %v", err)
+	}
+
+	if *updateTables {
+		if err := os.WriteFile(tablesFile, src, 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", tablesFile, err)
+		}
+		return
+	}
+
+	curr, err := os.ReadFile(tablesFile)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", tablesFile, err)
+	}
+	if !bytes.Equal(curr, src) {
+		t.Fatalf("%s is out of date; run \"go generate\"", tablesFile)
+	}
+}
+
+func TestTableComb(t *testing.T) {
+	w, v := 3, 2
+	e := (combBits + w*v - 1) / (w * v)
+	d := e * v
+
+	P := _AffineToR1(affine{Gx, Gy})
+	T := tableComb(P, w, v)
+	n := 1 << uint(w-1)
+	if len(T) != v*n {
+		t.Fatalf("failed comb table size test")
+	}
+
+	// Check each entry against a direct multiplication
+	pow2 := func(k int) (s scalar) {
+		s[k/64] = uint64(1) << uint(k%64)
+		return
+	}
+	for j := 0; j < v; j += 1 {
+		for u := 0; u < n; u += 1 {
+			m := pow2(e * j)
+			for i := 1; i < w; i += 1 {
+				if (u>>uint(i-1))&1 == 1 {
+					m = sadd(m, pow2(e*j+i*d))
+				}
+			}
+
+			expected := _R1toAffine(mulEndo(m, P, nil))
+			actual := _R1toAffine(_R2toR1(T[j*n+u]))
+			if expected != actual {
+				t.Fatalf("failed comb table test [%d][%d]", j, u)
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Generates known-answer tests with FourQlib
+// (https://github.com/microsoft/FourQlib), in the format read by
+// kat_test.go.  Build it against the FourQ_64bit_and_portable library and
+// write the output to fourqlib.rsp:
+//
+//   cc -O2 -I$FOURQLIB/FourQ_64bit_and_portable genkat.c \
+//      $FOURQLIB/FourQ_64bit_and_portable/libFourQ.a -o genkat
+//   ./genkat > fourqlib.rsp
+//
+// The secrets are generated deterministically, so that the output can be
+// checked in and regenerated.  kat_test.go generates the same secrets for
+// testdata/kat/ref.rsp, whose vectors are computed with internal/ref
+// instead, so the two files should agree except for their comments.
+
+#include <stdint.h>
+#include <stdio.h>
+#include "FourQ_api.h"
+
+#define COUNT 100
+
+static uint64_t state = 0x4b4154466f757251;
+
+static void random_secret(unsigned char *s, int i) {
+  for (int j = 0; j < 32; j++) {
+    state ^= state << 13;
+    state ^= state >> 7;
+    state ^= state << 17;
+    s[j] = (unsigned char)state;
+  }
+
+  // Clear the top 12 bits of half of the secrets, so that both secrets
+  // less than N and secrets that need to be reduced are covered
+  if (i % 2 == 0) {
+    s[30] &= 0x0f;
+    s[31] = 0;
+  }
+}
+
+static void print_hex(const char *name, const unsigned char *x) {
+  printf("%s = ", name);
+  for (int j = 0; j < 32; j++) {
+    printf("%02x", x[j]);
+  }
+  printf("\n");
+}
+
+int main(void) {
+  unsigned char sa[32], pa[32], sb[32], pb[32], ss[32], ss2[32];
+
+  printf("# FourQlib key generation and secret agreement\n\n");
+  for (int i = 0; i < COUNT; i++) {
+    random_secret(sa, i);
+    random_secret(sb, i);
+
+    if (CompressedPublicKeyGeneration(sa, pa) != ECCRYPTO_SUCCESS ||
+        CompressedPublicKeyGeneration(sb, pb) != ECCRYPTO_SUCCESS ||
+        CompressedSecretAgreement(sa, pb, ss) != ECCRYPTO_SUCCESS ||
+        CompressedSecretAgreement(sb, pa, ss2) != ECCRYPTO_SUCCESS) {
+      fprintf(stderr, "FourQlib error at vector %d\n", i);
+      return 1;
+    }
+    for (int j = 0; j < 32; j++) {
+      if (ss[j] != ss2[j]) {
+        fprintf(stderr, "shared secrets differ at vector %d\n", i);
+        return 1;
+      }
+    }
+
+    printf("count = %d\n", i);
+    print_hex("SecretA", sa);
+    print_hex("PublicA", pa);
+    print_hex("SecretB", sb);
+    print_hex("PublicB", pb);
+    print_hex("Shared", ss);
+    printf("\n");
+  }
+
+  return 0;
+}
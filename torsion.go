@@ -0,0 +1,59 @@
+package curve4q
+
+/********** Torsion **********/
+
+// The points of order dividing 392 form a subgroup isomorphic to
+// Z/8 x Z/7 x Z/7.  They are listed in smallOrderPoints in tables.go,
+// sorted by their encodings.
+const cofactor = 392
+
+// N^-1 mod 392, so that [torsionU * N]P is the component of P in the
+// 392-torsion
+const torsionU = 55
+
+// 392^-1 mod N, so that [392 * cofactorInv * s]P = [s]P for P of order N
+var cofactorInv = scalar{0x19fbeb877b2691f3, 0x5b1f37c5a96f4350, 0xa61e1145d66ad5e2, 0x0023ee839264702a}
+
+func r1IsNeutral(P r1) bool {
+	return P.X == fp2Zero && P.Y == P.Z
+}
+
+// Returns the component of P in the 392-torsion.  Note that this relies
+// on N and torsionU being odd, since mulWindowedCore adds N to even
+// scalars, which is only valid for points of order N.
+func torsionComponent(P r1) r1 {
+	w := windowWidth(false)
+	Q := mulWindowedCore(N, tableWindowed(P, w))
+	return mulWindowedCore(scalar{torsionU, 0, 0, 0}, tableWindowed(Q, w))
+}
+
+// ClearCofactor returns [392]P, which is in the subgroup of order N
+func (P *Point) ClearCofactor() *Point {
+	Q := mulCofactor(_AffineToR1(P.p))
+	return &Point{p: _R1toAffine(Q)}
+}
+
+// TorsionComponent returns the component of P in the subgroup of points
+// of order dividing 392.  P is the sum of this point and a point of
+// order dividing N.
+func (P *Point) TorsionComponent() *Point {
+	Q := torsionComponent(_AffineToR1(P.p))
+	return &Point{p: _R1toAffine(Q)}
+}
+
+// IsSmallOrder reports whether the order of P divides 392, including
+// the case where P is the neutral point.  Such points should be rejected
+// as public keys.
+func (P *Point) IsSmallOrder() bool {
+	return r1IsNeutral(mulCofactor(_AffineToR1(P.p)))
+}
+
+// SmallOrderPoints returns the 392 points of order dividing 392, sorted
+// by their encodings
+func SmallOrderPoints() []Point {
+	points := make([]Point, len(smallOrderPoints))
+	for i, P := range smallOrderPoints {
+		points[i].p = P
+	}
+	return points
+}
@@ -0,0 +1,89 @@
+package curve4q
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestSmallOrderPoints(t *testing.T) {
+	points := SmallOrderPoints()
+	if len(points) != cofactor {
+		t.Fatalf("failed small-order point count test")
+	}
+
+	O := affine{Ox, Oy}
+	seen := map[affine]bool{}
+	maxOrder := 0
+	for i := range points {
+		P := points[i].p
+		if seen[P] || !pointOnCurve(P.X, P.Y) || !points[i].IsSmallOrder() {
+			t.Fatalf("failed small-order point test [%d]", i)
+		}
+		seen[P] = true
+
+		if i > 0 {
+			prev := points[i-1].Bytes()
+			curr := points[i].Bytes()
+			if bytes.Compare(prev[:], curr[:]) >= 0 {
+				t.Fatalf("failed small-order point sorting test [%d]", i)
+			}
+		}
+
+		Q := _AffineToR1(P)
+		order := 1
+		for _R1toAffine(Q) != O {
+			Q = add(Q, _R1toR2(_AffineToR1(P)))
+			order += 1
+		}
+		if cofactor%order != 0 {
+			t.Fatalf("failed small-order point order test [%d]", i)
+		}
+		maxOrder = max(maxOrder, order)
+	}
+	if !seen[O] || maxOrder != 56 {
+		t.Fatalf("failed small-order group structure test")
+	}
+}
+
+func TestTorsion(t *testing.T) {
+	TEST_LOOPS := 20
+
+	points := SmallOrderPoints()
+	G := _AffineToR1(affine{Gx, Gy})
+	for i := 0; i < TEST_LOOPS; i += 1 {
+		var buf [1]byte
+		rand.Read(buf[:])
+		T := points[int(buf[0])%len(points)]
+
+		// P = [m]G + T, where [m]G has order N
+		m := randScalar()
+		PN := _R1toAffine(mulEndo(m, G, nil))
+		P := Point{p: _R1toAffine(add(_AffineToR1(PN), _R1toR2(_AffineToR1(T.p))))}
+
+		if P.TorsionComponent().p != T.p {
+			t.Fatalf("failed TorsionComponent test")
+		}
+
+		expected := _R1toAffine(mulCofactor(_AffineToR1(PN)))
+		if P.ClearCofactor().p != expected {
+			t.Fatalf("failed ClearCofactor test")
+		}
+
+		if P.IsSmallOrder() {
+			t.Fatalf("failed IsSmallOrder test")
+		}
+	}
+}
+
+func BenchmarkClearCofactor(b *testing.B) {
+	_, P := benchAPIInputs(1)
+	Q, _ := DecodePoint(&P[0])
+	benchOps(b, func(i int) { Q.ClearCofactor() })
+}
+
+func BenchmarkIsSmallOrder(b *testing.B) {
+	_, P := benchAPIInputs(1)
+	Q, _ := DecodePoint(&P[0])
+	benchOps(b, func(i int) { benchBool = Q.IsSmallOrder() })
+}
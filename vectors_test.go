@@ -0,0 +1,153 @@
+package curve4q
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+)
+
+// Checks the layered vectors generated by cmd/fourq-vectors, so that the
+// vectors given to other implementations are known to agree with this one
+type vectorFile struct {
+	Field []struct {
+		A, B, Add, Sub, Mul, Sqr, Inv string
+	} `json:"field"`
+	Points []struct {
+		Comment, P, Q, Add, Double string
+	} `json:"points"`
+	Encoding []struct {
+		Comment, Encoding string
+		Valid             bool
+		X, Y              string
+	} `json:"encoding"`
+	ScalarBaseMult []struct {
+		Scalar, Result string
+	} `json:"scalarBaseMult"`
+	ScalarMult []struct {
+		Scalar, Point, Result string
+	} `json:"scalarMult"`
+	Decomposition []struct {
+		Scalar string
+		A      [4]string
+		Signs  []uint64
+		Digits []uint64
+	} `json:"decomposition"`
+}
+
+func mustHex32(t *testing.T, s string) [32]byte {
+	out, ok := decodeHex32(s)
+	if !ok {
+		t.Fatalf("malformed vector %q", s)
+	}
+	return out
+}
+
+func mustFp2(t *testing.T, s string) fp2elt {
+	buf := mustHex32(t, s)
+	x, ok := decodeFp2(&buf)
+	if !ok {
+		t.Fatalf("malformed field element %q", s)
+	}
+	return x
+}
+
+func mustPoint(t *testing.T, s string) r1 {
+	buf := mustHex32(t, s)
+	P, err := decode(buf[:])
+	if err != nil {
+		t.Fatalf("malformed point %q", s)
+	}
+	return _AffineToR1(P)
+}
+
+func hexFp2(x fp2elt) string {
+	fpreduce(&x[0])
+	fpreduce(&x[1])
+	out := encodeFp2(x)
+	return hex.EncodeToString(out[:])
+}
+
+func hexR1(P r1) string {
+	return hex.EncodeToString(encode(_R1toAffine(P)))
+}
+
+func TestVectors(t *testing.T) {
+	data, err := os.ReadFile("testdata/vectors/fourq.json")
+	if err != nil {
+		t.Fatalf("failed to read vectors: %v", err)
+	}
+
+	var f vectorFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		t.Fatalf("failed to parse vectors: %v", err)
+	}
+
+	for i, v := range f.Field {
+		a, b := mustFp2(t, v.A), mustFp2(t, v.B)
+		if hexFp2(fp2add(a, b)) != v.Add || hexFp2(fp2sub(a, b)) != v.Sub ||
+			hexFp2(fp2mul(a, b)) != v.Mul || hexFp2(fp2sqr(a)) != v.Sqr || hexFp2(fp2inv(a)) != v.Inv {
+			t.Fatalf("failed field vector test [%d]", i)
+		}
+	}
+
+	for i, v := range f.Points {
+		P, Q := mustPoint(t, v.P), mustPoint(t, v.Q)
+		if hexR1(add(P, _R1toR2(Q))) != v.Add || hexR1(dbl(P)) != v.Double {
+			t.Fatalf("failed point vector test [%d] (%s)", i, v.Comment)
+		}
+	}
+
+	for i, v := range f.Encoding {
+		buf := mustHex32(t, v.Encoding)
+		P, err := decode(buf[:])
+		if (err == nil) != v.Valid {
+			t.Fatalf("failed decode vector test [%d] (%s)", i, v.Comment)
+		}
+		if v.Valid && (hexFp2(P.X) != v.X || hexFp2(P.Y) != v.Y || hex.EncodeToString(encode(P)) != v.Encoding) {
+			t.Fatalf("failed encode vector test [%d] (%s)", i, v.Comment)
+		}
+	}
+
+	for i, v := range f.ScalarBaseMult {
+		m := mustHex32(t, v.Scalar)
+		for name, f := range map[string]func(dst, in *[32]byte) error{
+			"ScalarBaseMultWin":     ScalarBaseMultWin,
+			"ScalarBaseMultEndo":    ScalarBaseMultEndo,
+			"ScalarBaseMultVarTime": ScalarBaseMultVarTime,
+		} {
+			var Q [32]byte
+			if err := f(&Q, &m); err != nil || hex.EncodeToString(Q[:]) != v.Result {
+				t.Fatalf("failed %s vector test [%d]: %v", name, i, err)
+			}
+		}
+	}
+
+	for i, v := range f.ScalarMult {
+		m, P := mustHex32(t, v.Scalar), mustHex32(t, v.Point)
+		for name, f := range map[string]func(dst, in, base *[32]byte) error{
+			"ScalarMult":        ScalarMult,
+			"ScalarMultVarTime": ScalarMultVarTime,
+		} {
+			var Q [32]byte
+			if err := f(&Q, &m, &P); err != nil || hex.EncodeToString(Q[:]) != v.Result {
+				t.Fatalf("failed %s vector test [%d]: %v", name, i, err)
+			}
+		}
+	}
+
+	for i, v := range f.Decomposition {
+		m := mustHex32(t, v.Scalar)
+		a := Decompose(&m)
+		s, d := Recode(a)
+		for j := range a {
+			if fmt.Sprintf("%016x", a[j]) != v.A[j] {
+				t.Fatalf("failed decomposition vector test [%d]", i)
+			}
+		}
+		if fmt.Sprint(s[:]) != fmt.Sprint(v.Signs) || fmt.Sprint(d[:]) != fmt.Sprint(v.Digits) {
+			t.Fatalf("failed recoding vector test [%d]", i)
+		}
+	}
+}
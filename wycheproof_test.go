@@ -0,0 +1,105 @@
+package curve4q
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// Runs the Wycheproof-style ECDH vectors generated by cmd/fourq-wycheproof.
+// A "valid" test must produce the given shared secret, an "invalid" test
+// must be rejected with an error, and an "acceptable" test may be either
+// rejected or produce the given shared secret.  No test may panic.
+
+type wycheproofTest struct {
+	TcID    int      `json:"tcId"`
+	Comment string   `json:"comment"`
+	Flags   []string `json:"flags"`
+	Private string   `json:"private"`
+	Public  string   `json:"public"`
+	Shared  string   `json:"shared"`
+	Result  string   `json:"result"`
+}
+
+type wycheproofFile struct {
+	Algorithm     string `json:"algorithm"`
+	NumberOfTests int    `json:"numberOfTests"`
+	TestGroups    []struct {
+		Type  string           `json:"type"`
+		Tests []wycheproofTest `json:"tests"`
+	} `json:"testGroups"`
+}
+
+func decodeHex32(s string) (out [32]byte, ok bool) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != 32 {
+		return out, false
+	}
+	return [32]byte(b), true
+}
+
+func TestWycheproof(t *testing.T) {
+	data, err := os.ReadFile("testdata/wycheproof/fourq_ecdh_test.json")
+	if err != nil {
+		t.Fatalf("failed to read vectors: %v", err)
+	}
+
+	var f wycheproofFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		t.Fatalf("failed to parse vectors: %v", err)
+	}
+
+	count := 0
+	for _, group := range f.TestGroups {
+		if group.Type != "EcdhTest" {
+			t.Fatalf("unknown test type %q", group.Type)
+		}
+
+		for _, tc := range group.Tests {
+			count += 1
+			m, ok1 := decodeHex32(tc.Private)
+			P, ok2 := decodeHex32(tc.Public)
+			if !ok1 || !ok2 {
+				t.Fatalf("malformed test [%d]", tc.TcID)
+			}
+
+			dh := map[string]func(dst *[32]byte) error{
+				"ScalarMult": func(dst *[32]byte) error {
+					return ScalarMult(dst, &m, &P)
+				},
+				"PrivateKey.ScalarMult": func(dst *[32]byte) error {
+					return NewPrivateKey(&m).ScalarMult(dst, &P)
+				},
+			}
+			for name, f := range dh {
+				var Q [32]byte
+				var err error
+				if panics(func() { err = f(&Q) }) {
+					t.Fatalf("failed Wycheproof %s no-panic test [%d]", name, tc.TcID)
+				}
+				rejected := err != nil
+				matches := !rejected && hex.EncodeToString(Q[:]) == tc.Shared
+
+				var pass bool
+				switch tc.Result {
+				case "valid":
+					pass = matches
+				case "invalid":
+					pass = rejected
+				case "acceptable":
+					pass = rejected || matches
+				default:
+					t.Fatalf("unknown result %q [%d]", tc.Result, tc.TcID)
+				}
+				if !pass {
+					t.Fatalf("failed Wycheproof %s test [%d] (%s, %v)", name, tc.TcID, tc.Comment, tc.Flags)
+				}
+			}
+		}
+	}
+
+	if count != f.NumberOfTests {
+		t.Fatalf("failed Wycheproof test count %d != %d", count, f.NumberOfTests)
+	}
+}